@@ -0,0 +1,9 @@
+data "keboola_current_token" "current" {}
+
+# Fail early when the module runs with a non-master token.
+check "master_token" {
+  assert {
+    condition     = data.keboola_current_token.current.is_master
+    error_message = "Project ${data.keboola_current_token.current.project_name} must be managed with a master token."
+  }
+}
@@ -0,0 +1,20 @@
+# The latest run of the extractor.
+data "keboola_jobs" "extractor_latest" {
+  component_id     = "ex-generic-v2"
+  configuration_id = "123456"
+  limit            = 1
+}
+
+# The failed jobs of the last day.
+data "keboola_jobs" "failed" {
+  statuses      = ["error", "terminated"]
+  created_after = timeadd(plantimestamp(), "-24h")
+}
+
+output "extractor_latest_status" {
+  value = one(data.keboola_jobs.extractor_latest.jobs[*].status)
+}
+
+output "failed_job_errors" {
+  value = { for job in data.keboola_jobs.failed.jobs : job.id => job.error_message }
+}
@@ -0,0 +1,4 @@
+# The ID of a configuration managed outside of this module, in the same format as the id attribute.
+output "extractor_id" {
+  value = provider::keboola::config_id(keboola_branch.dev.id, "ex-generic-v2", "456")
+}
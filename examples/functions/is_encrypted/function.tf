@@ -0,0 +1,9 @@
+variable "api_token" {
+  type      = string
+  sensitive = true
+
+  validation {
+    condition     = provider::keboola::is_encrypted(var.api_token)
+    error_message = "The API token must be encrypted, see the keboola_encryption resource."
+  }
+}
@@ -0,0 +1,7 @@
+# Compare a JSON file with the configuration in the state, ignoring the formatting.
+check "extractor_configuration" {
+  assert {
+    condition     = provider::keboola::normalize_json(file("${path.module}/extractor.json")) == keboola_component_configuration.extractor.configuration
+    error_message = "The extractor configuration differs from extractor.json."
+  }
+}
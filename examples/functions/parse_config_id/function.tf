@@ -0,0 +1,7 @@
+locals {
+  config = provider::keboola::parse_config_id(keboola_component_configuration.extractor.id)
+}
+
+output "component_id" {
+  value = local.config.component_id
+}
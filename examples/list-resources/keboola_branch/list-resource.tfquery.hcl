@@ -0,0 +1,4 @@
+# Find all branches of the project (Terraform 1.14+).
+list "keboola_branch" "all" {
+  provider = keboola
+}
@@ -0,0 +1,9 @@
+# Find existing configurations of the component in the default branch (Terraform 1.14+).
+list "keboola_component_configuration" "extractors" {
+  provider         = keboola
+  include_resource = true
+
+  config {
+    component_id = "ex-generic-v2"
+  }
+}
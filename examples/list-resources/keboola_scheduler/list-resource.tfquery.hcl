@@ -0,0 +1,8 @@
+# Find the schedule of the scheduler configuration (Terraform 1.14+).
+list "keboola_scheduler" "nightly" {
+  provider = keboola
+
+  config {
+    configuration_id = "123456"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = keboola_branch.dev
+  identity = {
+    id = 123
+  }
+}
@@ -0,0 +1,2 @@
+# The numeric ID of the branch
+terraform import keboola_branch.dev 123
@@ -0,0 +1,2 @@
+# The ID of the branch and the metadata key, separated by a slash
+terraform import keboola_branch_metadata.description 123/KBC.projectDescription
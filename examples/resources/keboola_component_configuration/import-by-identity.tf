@@ -0,0 +1,8 @@
+import {
+  to = keboola_component_configuration.extractor
+  identity = {
+    branch_id        = 123
+    component_id     = "ex-generic-v2"
+    configuration_id = "456"
+  }
+}
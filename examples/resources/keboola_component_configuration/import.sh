@@ -0,0 +1,2 @@
+# The ID is assembled as branchId/componentId/configId
+terraform import keboola_component_configuration.extractor 123/ex-generic-v2/456
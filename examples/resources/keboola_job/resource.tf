@@ -0,0 +1,31 @@
+resource "keboola_component_configuration" "extractor" {
+  name         = "Currency rates"
+  component_id = "ex-generic-v2"
+  configuration = jsonencode({
+    parameters = {
+      api = {
+        baseUrl = "https://api.exchangerate.host/"
+      }
+    }
+  })
+}
+
+# Runs the extractor after each change of its configuration.
+resource "keboola_job" "extractor" {
+  component_id     = keboola_component_configuration.extractor.component_id
+  configuration_id = keboola_component_configuration.extractor.configuration_id
+  variable_values = {
+    currency = "EUR"
+  }
+  triggers = {
+    version = keboola_component_configuration.extractor.version
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
+
+output "extractor_job_status" {
+  value = keboola_job.extractor.status
+}
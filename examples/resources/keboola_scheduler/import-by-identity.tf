@@ -0,0 +1,6 @@
+import {
+  to = keboola_scheduler.daily
+  identity = {
+    id = "1234"
+  }
+}
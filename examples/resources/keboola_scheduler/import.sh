@@ -0,0 +1,2 @@
+# The ID of the schedule
+terraform import keboola_scheduler.daily 1234
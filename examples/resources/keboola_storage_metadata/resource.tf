@@ -0,0 +1,15 @@
+# Description of a bucket shown in the Keboola UI.
+resource "keboola_storage_metadata" "bucket_description" {
+  bucket_id = "in.c-main"
+  key       = "KBC.description"
+  value     = "Raw data extracted from the CRM"
+}
+
+# Custom metadata of a table column.
+resource "keboola_storage_metadata" "email_pii" {
+  table_id          = "in.c-main.users"
+  column_name       = "email"
+  metadata_provider = "data-catalog"
+  key               = "pii"
+  value             = "true"
+}
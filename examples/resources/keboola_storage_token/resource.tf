@@ -0,0 +1,14 @@
+resource "time_rotating" "token" {
+  rotation_days = 30
+}
+
+# Token for an external system with read access to a single bucket.
+resource "keboola_storage_token" "reporting" {
+  description = "Reporting service"
+  bucket_permissions = {
+    "out.c-reporting" = "read"
+  }
+  component_access = ["keboola.ex-db-snowflake"]
+  expires_in       = 60 * 60 * 24 * 90
+  rotation         = time_rotating.token.id
+}
@@ -0,0 +1,365 @@
+// Package generator exports an existing Keboola project to Terraform configuration.
+//
+// The branches, their metadata, the component configurations with rows and the schedules are written
+// as resources of the provider, together with import blocks, so "terraform plan" adopts the existing objects
+// instead of creating new ones. The generated files should be reviewed before the first apply.
+package generator
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/transport"
+)
+
+const (
+	// ProviderFile requires the provider, it is configured by the environment variables.
+	ProviderFile = "provider.tf"
+	// BranchesFile contains the development branches and the metadata of all branches.
+	BranchesFile = "branches.tf"
+	// ConfigurationsFile contains the component configurations of all branches.
+	ConfigurationsFile = "configurations.tf"
+	// SchedulesFile contains the schedules.
+	SchedulesFile = "schedules.tf"
+	// ImportsFile contains the import blocks of all generated resources, it can be removed after the first apply.
+	ImportsFile = "imports.tf"
+
+	// schedulerComponentID is the component of the configurations activated as schedules.
+	schedulerComponentID = keboola.ComponentID("keboola.scheduler")
+)
+
+// ErrFileExists is returned by Files.Write instead of overwriting an existing file.
+var ErrFileExists = errors.New("file already exists")
+
+// Files are the generated Terraform files by their name.
+type Files map[string][]byte
+
+// Write writes the files to the directory, which is created if it does not exist.
+// Existing files are not overwritten, nothing is written if any of the files exists.
+func (f Files) Write(dir string) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("could not create directory %s: %w", dir, err)
+	}
+
+	names := slices.Sorted(maps.Keys(f))
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		_, err := os.Lstat(path)
+		if err == nil {
+			return fmt.Errorf("%s: %w", path, ErrFileExists)
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not check %s: %w", path, err)
+		}
+	}
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("%s: %w", path, ErrFileExists)
+		}
+		if err != nil {
+			return fmt.Errorf("could not create %s: %w", path, err)
+		}
+
+		_, err = file.Write(f[name])
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("could not write %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// Generator walks a project using the API client and renders its objects as Terraform resources.
+type Generator struct {
+	client apiclient.Client
+
+	// names are the used resource names by the resource type
+	names map[string]map[string]bool
+}
+
+// New creates a generator reading the project using the API client.
+func New(client apiclient.Client) *Generator {
+	return &Generator{client: client, names: make(map[string]map[string]bool)}
+}
+
+// Export connects to the project with the token and writes its Terraform configuration to the directory.
+func Export(ctx context.Context, host, token, dir string) error {
+	httpClient := transport.NewClient(transport.Config{
+		Retry:       transport.DefaultRetryConfig(),
+		RateLimiter: nil,
+		Transport:   nil,
+	})
+	api, err := keboola.NewAuthorizedAPI(ctx, host, token, keboola.WithClient(&httpClient))
+	if err != nil {
+		return fmt.Errorf("could not initialize Keboola client: %w", err)
+	}
+
+	files, err := New(apiclient.New(api)).Generate(ctx)
+	if err != nil {
+		return err
+	}
+
+	return files.Write(dir)
+}
+
+// project collects the generated blocks by the file.
+type project struct {
+	branches       *hclwrite.Body
+	configurations *hclwrite.Body
+	schedules      *hclwrite.Body
+	imports        *hclwrite.Body
+}
+
+// Generate reads the project and renders the files.
+func (g *Generator) Generate(ctx context.Context) (Files, error) {
+	files := map[string]*hclwrite.File{
+		ProviderFile:       hclwrite.NewEmptyFile(),
+		BranchesFile:       hclwrite.NewEmptyFile(),
+		ConfigurationsFile: hclwrite.NewEmptyFile(),
+		SchedulesFile:      hclwrite.NewEmptyFile(),
+		ImportsFile:        hclwrite.NewEmptyFile(),
+	}
+	for _, file := range files {
+		appendComment(file.Body(), "Generated from an existing Keboola project, review before applying.")
+	}
+	writeProvider(files[ProviderFile].Body())
+
+	p := &project{
+		branches:       files[BranchesFile].Body(),
+		configurations: files[ConfigurationsFile].Body(),
+		schedules:      files[SchedulesFile].Body(),
+		imports:        files[ImportsFile].Body(),
+	}
+
+	branches, err := g.client.ListBranches(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list branches: %w", err)
+	}
+	slices.SortFunc(branches, func(a, b *keboola.Branch) int { return cmp.Compare(a.ID, b.ID) })
+
+	// Branch IDs are rendered as references to the generated branches, the default branch is not managed
+	branchIDs := make(map[keboola.BranchID]hclwrite.Tokens, len(branches))
+	for _, branch := range branches {
+		if branchIDs[branch.ID], err = g.generateBranch(ctx, p, branch); err != nil {
+			return nil, err
+		}
+	}
+
+	schedulerConfigs := make(map[keboola.ConfigID]string)
+	for _, branch := range branches {
+		if err := g.generateConfigs(ctx, p, branch, branchIDs[branch.ID], schedulerConfigs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := g.generateSchedules(ctx, p, schedulerConfigs); err != nil {
+		return nil, err
+	}
+
+	result := make(Files, len(files))
+	for name, file := range files {
+		result[name] = hclwrite.Format(file.Bytes())
+	}
+
+	return result, nil
+}
+
+// generateBranch renders a development branch and the metadata of the branch.
+// It returns the tokens of the branch ID for the resources in the branch.
+func (g *Generator) generateBranch(
+	ctx context.Context,
+	p *project,
+	branch *keboola.Branch,
+) (hclwrite.Tokens, error) {
+	branchID := hclwrite.TokensForValue(cty.NumberIntVal(int64(branch.ID)))
+	if !branch.IsDefault {
+		name := g.name("keboola_branch", "branch", branch.ID.String())
+		body := appendResource(p.branches, "keboola_branch", name)
+		body.SetAttributeValue("name", cty.StringVal(branch.Name))
+		body.SetAttributeValue("description", cty.StringVal(branch.Description))
+		appendImport(p.imports, "keboola_branch", name, strconv.Itoa(int(branch.ID)))
+
+		branchID = hclwrite.TokensForTraversal(reference("keboola_branch", name, "id"))
+	}
+
+	metadata, err := g.client.ListBranchMetadata(ctx, branch.BranchKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not list metadata of branch %s: %w", branch.ID, err)
+	}
+	slices.SortFunc(metadata, func(a, b keboola.MetadataDetail) int { return strings.Compare(a.Key, b.Key) })
+
+	for _, item := range metadata {
+		name := g.name("keboola_branch_metadata", "branch", branch.ID.String(), item.Key)
+		body := appendResource(p.branches, "keboola_branch_metadata", name)
+		body.SetAttributeRaw("branch_id", branchID)
+		body.SetAttributeValue("key", cty.StringVal(item.Key))
+		body.SetAttributeValue("value", cty.StringVal(item.Value))
+		appendImport(p.imports, "keboola_branch_metadata", name, fmt.Sprintf("%d/%s", branch.ID, item.Key))
+	}
+
+	return branchID, nil
+}
+
+// generateConfigs renders the configurations of the branch with their rows.
+// The names of the scheduler configurations of the default branch are collected for the schedules.
+func (g *Generator) generateConfigs(
+	ctx context.Context,
+	p *project,
+	branch *keboola.Branch,
+	branchID hclwrite.Tokens,
+	schedulerConfigs map[keboola.ConfigID]string,
+) error {
+	components, err := g.client.ListConfigsAndRows(ctx, branch.BranchKey)
+	if err != nil {
+		return fmt.Errorf("could not list configurations of branch %s: %w", branch.ID, err)
+	}
+	slices.SortFunc(components, func(a, b *keboola.ComponentWithConfigs) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+
+	for _, component := range components {
+		configs := slices.Clone(component.Configs)
+		slices.SortFunc(configs, func(a, b *keboola.ConfigWithRows) int {
+			return strings.Compare(a.ID.String(), b.ID.String())
+		})
+
+		for _, config := range configs {
+			// The key of the listed configuration is set from the request
+			key := keboola.ConfigKey{BranchID: branch.ID, ComponentID: component.ID, ID: config.ID}
+
+			nameParts := []string{component.ID.String(), config.ID.String()}
+			if !branch.IsDefault {
+				nameParts = append([]string{"branch", branch.ID.String()}, nameParts...)
+			}
+			name := g.name("keboola_component_configuration", nameParts...)
+			if branch.IsDefault && component.ID == schedulerComponentID {
+				schedulerConfigs[config.ID] = name
+			}
+
+			if err := generateConfig(p.configurations, name, key, branchID, config); err != nil {
+				return err
+			}
+			appendImport(p.imports, "keboola_component_configuration", name, configuration.FormatConfigModelID(key))
+		}
+	}
+
+	return nil
+}
+
+// generateConfig renders the configuration, the content is rendered in the jsonencode form.
+func generateConfig(
+	parent *hclwrite.Body,
+	name string,
+	key keboola.ConfigKey,
+	branchID hclwrite.Tokens,
+	config *keboola.ConfigWithRows,
+) error {
+	content, err := jsonEncode(config.Content)
+	if err != nil {
+		return fmt.Errorf("could not render configuration %s: %w", configuration.FormatConfigModelID(key), err)
+	}
+
+	body := appendResource(parent, "keboola_component_configuration", name)
+	body.SetAttributeRaw("branch_id", branchID)
+	body.SetAttributeValue("component_id", cty.StringVal(key.ComponentID.String()))
+	body.SetAttributeValue("configuration_id", cty.StringVal(key.ID.String()))
+	body.SetAttributeValue("name", cty.StringVal(config.Name))
+	body.SetAttributeValue("description", cty.StringVal(config.Description))
+	body.SetAttributeValue("is_disabled", cty.BoolVal(config.IsDisabled))
+	body.SetAttributeRaw("configuration", content)
+
+	if len(config.Rows) == 0 {
+		return nil
+	}
+
+	rows := make([]hclwrite.Tokens, 0, len(config.Rows))
+	for _, row := range config.Rows {
+		rowContent, err := jsonEncode(row.Content)
+		if err != nil {
+			return fmt.Errorf(
+				"could not render row %s of configuration %s: %w", row.ID, configuration.FormatConfigModelID(key), err,
+			)
+		}
+
+		rows = append(rows, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+			{Name: hclwrite.TokensForIdentifier("name"), Value: hclwrite.TokensForValue(cty.StringVal(row.Name))},
+			{
+				Name:  hclwrite.TokensForIdentifier("description"),
+				Value: hclwrite.TokensForValue(cty.StringVal(row.Description)),
+			},
+			{
+				Name:  hclwrite.TokensForIdentifier("is_disabled"),
+				Value: hclwrite.TokensForValue(cty.BoolVal(row.IsDisabled)),
+			},
+			{Name: hclwrite.TokensForIdentifier("configuration_row"), Value: rowContent},
+		}))
+	}
+	body.SetAttributeRaw("rows", hclwrite.TokensForTuple(rows))
+
+	return nil
+}
+
+// generateSchedules renders the schedules, referencing the generated scheduler configurations.
+func (g *Generator) generateSchedules(
+	ctx context.Context,
+	p *project,
+	schedulerConfigs map[keboola.ConfigID]string,
+) error {
+	schedules, err := g.client.ListSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list schedules: %w", err)
+	}
+	slices.SortFunc(schedules, func(a, b *keboola.Schedule) int { return strings.Compare(string(a.ID), string(b.ID)) })
+
+	for _, schedule := range schedules {
+		name := g.name("keboola_scheduler", "schedule", string(schedule.ID))
+		body := appendResource(p.schedules, "keboola_scheduler", name)
+		if config, ok := schedulerConfigs[schedule.ConfigID]; ok {
+			body.SetAttributeTraversal(
+				"configuration_id", reference("keboola_component_configuration", config, "configuration_id"),
+			)
+		} else {
+			body.SetAttributeValue("configuration_id", cty.StringVal(schedule.ConfigID.String()))
+		}
+		appendImport(p.imports, "keboola_scheduler", name, string(schedule.ID))
+	}
+
+	return nil
+}
+
+// name returns a unique name of a resource of the type, assembled from the parts.
+func (g *Generator) name(resourceType string, parts ...string) string {
+	if g.names[resourceType] == nil {
+		g.names[resourceType] = make(map[string]bool)
+	}
+	used := g.names[resourceType]
+
+	base := resourceName(parts...)
+	name := base
+	for i := 2; used[name]; i++ {
+		name = base + "_" + strconv.Itoa(i)
+	}
+	used[name] = true
+
+	return name
+}
@@ -0,0 +1,102 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// invalidNameRegexp matches the characters not allowed in a resource name.
+var invalidNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// resourceName joins the parts by underscores and replaces the characters not allowed in a resource name.
+// A name not starting with a letter is prefixed by an underscore.
+func resourceName(parts ...string) string {
+	name := invalidNameRegexp.ReplaceAllString(strings.Join(parts, "_"), "_")
+	if name == "" || !hclsyntax.ValidIdentifier(name[:1]) {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// jsonEncode returns the tokens of the jsonencode call with the content rendered as an HCL value.
+// Nil content results in an empty object.
+func jsonEncode(content *orderedmap.OrderedMap) (hclwrite.Tokens, error) {
+	raw := []byte("{}")
+	if content != nil {
+		var err error
+		if raw, err = json.Marshal(content); err != nil {
+			return nil, fmt.Errorf("could not encode content: %w", err)
+		}
+	}
+
+	// jsonencode sorts the object keys, so the rendered order does not change the resulting JSON
+	valueType, err := ctyjson.ImpliedType(raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode content: %w", err)
+	}
+
+	value, err := ctyjson.Unmarshal(raw, valueType)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode content: %w", err)
+	}
+
+	return hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForValue(value)), nil
+}
+
+// appendResource appends a resource block separated by an empty line and returns its body.
+func appendResource(parent *hclwrite.Body, resourceType, name string) *hclwrite.Body {
+	parent.AppendNewline()
+
+	return parent.AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+// appendImport appends an import block of the resource.
+func appendImport(parent *hclwrite.Body, resourceType, name, id string) {
+	parent.AppendNewline()
+
+	body := parent.AppendNewBlock("import", nil).Body()
+	body.SetAttributeTraversal("to", address(resourceType, name))
+	body.SetAttributeValue("id", cty.StringVal(id))
+}
+
+// appendComment appends a single line comment.
+func appendComment(parent *hclwrite.Body, comment string) {
+	parent.AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + comment + "\n")},
+	})
+}
+
+// writeProvider renders the provider requirement, the host and the token are read from the environment variables.
+func writeProvider(body *hclwrite.Body) {
+	body.AppendNewline()
+
+	terraform := body.AppendNewBlock("terraform", nil).Body()
+	providers := terraform.AppendNewBlock("required_providers", nil).Body()
+	providers.SetAttributeRaw("keboola", hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+		{Name: hclwrite.TokensForIdentifier("source"), Value: hclwrite.TokensForValue(cty.StringVal("keboola/keboola"))},
+	}))
+
+	body.AppendNewline()
+	appendComment(body, "The host and the token are read from the KBC_HOST and KBC_TOKEN environment variables.")
+	body.AppendNewBlock("provider", []string{"keboola"})
+}
+
+// address returns the traversal of a resource, e.g. keboola_branch.dev.
+func address(resourceType, name string) hcl.Traversal {
+	return hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: name}}
+}
+
+// reference returns the traversal of the attribute of a resource, e.g. keboola_branch.dev.id.
+func reference(resourceType, name, attribute string) hcl.Traversal {
+	return append(address(resourceType, name), hcl.TraverseAttr{Name: attribute})
+}
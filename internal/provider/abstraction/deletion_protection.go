@@ -0,0 +1,56 @@
+package abstraction
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeletionProtectionAttributeName is the name of the attribute enforced by ExecuteDelete.
+const DeletionProtectionAttributeName = "deletion_protection"
+
+// DeletionProtectionAttribute returns the `deletion_protection` attribute.
+// ExecuteDelete enforces it for each resource with the attribute in its schema.
+// The Terraform model must contain the matching field: DeletionProtection types.Bool `tfsdk:"deletion_protection"`.
+func DeletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "If true, the resource cannot be destroyed, neither directly nor by a replacement. " +
+			"Set it to false and apply before destroying the resource.",
+		MarkdownDescription: "If true, the resource cannot be destroyed, neither directly nor by a replacement. " +
+			"Set it to `false` and apply before destroying the resource.",
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// refuseIfProtected adds an error diagnostic and returns true if the deletion protection of the resource is on.
+func refuseIfProtected(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) bool {
+	if _, ok := state.Schema.GetAttributes()[DeletionProtectionAttributeName]; !ok {
+		return false
+	}
+
+	var protected types.Bool
+	diags.Append(state.GetAttribute(ctx, path.Root(DeletionProtectionAttributeName), &protected)...)
+	if diags.HasError() {
+		return true
+	}
+
+	if !protected.ValueBool() {
+		return false
+	}
+
+	diags.AddAttributeError(
+		path.Root(DeletionProtectionAttributeName),
+		"Resource is protected from deletion",
+		"Cannot delete resource, deletion_protection is enabled. "+
+			"Set deletion_protection = false and apply the change before destroying or replacing the resource.",
+	)
+
+	return true
+}
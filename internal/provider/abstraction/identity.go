@@ -0,0 +1,23 @@
+package abstraction
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// setIdentity sets the identity of the resource from the model, see BaseResource.Identity.
+// The identity is nil if the resource has no identity schema or Terraform does not support identities.
+func (r *BaseResource[TfModel, ApiModel]) setIdentity(
+	ctx context.Context,
+	identity *tfsdk.ResourceIdentity,
+	model TfModel,
+	diags *diag.Diagnostics,
+) {
+	if r.Identity == nil || identity == nil {
+		return
+	}
+
+	diags.Append(identity.Set(ctx, r.Identity(model))...)
+}
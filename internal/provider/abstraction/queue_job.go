@@ -0,0 +1,137 @@
+package abstraction
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+)
+
+// Job Queue job statuses, see https://app.swaggerhub.com/apis-docs/keboola/job-queue-api.
+const (
+	QueueJobStatusCreated     = "created"
+	QueueJobStatusWaiting     = "waiting"
+	QueueJobStatusProcessing  = "processing"
+	QueueJobStatusSuccess     = "success"
+	QueueJobStatusWarning     = "warning"
+	QueueJobStatusError       = "error"
+	QueueJobStatusTerminating = "terminating"
+	QueueJobStatusTerminated  = "terminated"
+	QueueJobStatusCancelled   = "cancelled"
+)
+
+// QueueJobPolling configures how often a Job Queue job is polled.
+type QueueJobPolling struct {
+	// InitialInterval is the wait before the first poll, it doubles with each further poll.
+	InitialInterval time.Duration
+	// MaxInterval caps a single wait.
+	MaxInterval time.Duration
+}
+
+// DefaultQueueJobPolling returns the polling settings used by WaitForQueueJob.
+// The jobs run components, so they take longer than Storage jobs.
+func DefaultQueueJobPolling() QueueJobPolling {
+	return QueueJobPolling{
+		InitialInterval: time.Second,
+		MaxInterval:     15 * time.Second,
+	}
+}
+
+// QueueJobGetter fetches the current state of a Job Queue job.
+type QueueJobGetter func(ctx context.Context, key keboola.JobKey) (*keboola.QueueJob, error)
+
+// QueueJobError is returned when a Job Queue job does not succeed or cannot be waited for.
+type QueueJobError struct {
+	JobID   keboola.JobID
+	Status  string
+	Message string
+	// Err is the cause, if the job was not finished, e.g. the context deadline has been exceeded
+	Err error
+}
+
+// Error returns the job ID, the status and the result message of the job.
+func (e *QueueJobError) Error() string {
+	msg := fmt.Sprintf("job %s", e.JobID)
+
+	if e.Err != nil {
+		return msg + " did not finish: " + e.Err.Error()
+	}
+
+	msg += " finished with status " + e.Status
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+
+	return msg
+}
+
+// Unwrap returns the cause.
+func (e *QueueJobError) Unwrap() error {
+	return e.Err
+}
+
+// IsQueueJobFinished returns true if the job is in a terminal state.
+func IsQueueJobFinished(job *keboola.QueueJob) bool {
+	return job.IsFinished || slices.Contains([]string{
+		QueueJobStatusSuccess,
+		QueueJobStatusWarning,
+		QueueJobStatusError,
+		QueueJobStatusTerminated,
+		QueueJobStatusCancelled,
+	}, job.Status)
+}
+
+// WaitForQueueJob polls the job using the API client until it finishes, see PollQueueJob.
+func WaitForQueueJob(ctx context.Context, jobs apiclient.Jobs, job *keboola.QueueJob) (*keboola.QueueJob, error) {
+	return PollQueueJob(ctx, jobs.GetJob, job, DefaultQueueJobPolling())
+}
+
+// PollQueueJob polls the job until it finishes and returns the finished job.
+// A job finished with another status than success or warning is returned together with a *QueueJobError,
+// so the caller can still store the result.
+// Polling stops when the context is done, so the deadline of the resource operation is respected.
+func PollQueueJob(
+	ctx context.Context,
+	getJob QueueJobGetter,
+	job *keboola.QueueJob,
+	polling QueueJobPolling,
+) (*keboola.QueueJob, error) {
+	wait := polling.InitialInterval
+	for {
+		if IsQueueJobFinished(job) {
+			if job.Status == QueueJobStatusSuccess || job.Status == QueueJobStatusWarning {
+				return job, nil
+			}
+
+			return job, &QueueJobError{JobID: job.ID, Status: job.Status, Message: job.Result.Message}
+		}
+
+		tflog.Debug(ctx, "Waiting for job", map[string]any{
+			"job_id": job.ID.String(),
+			"status": job.Status,
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, &QueueJobError{JobID: job.ID, Status: job.Status, Err: ctx.Err()}
+		case <-timer.C:
+		}
+
+		wait = min(wait*2, polling.MaxInterval)
+
+		current, err := getJob(ctx, job.JobKey)
+		if err != nil {
+			return nil, &QueueJobError{JobID: job.ID, Status: job.Status, Err: err}
+		}
+
+		job = current
+	}
+}
@@ -2,12 +2,21 @@ package abstraction
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// ErrResourceNotFound is returned by a read function when the remote object no longer exists.
+// The resource is then removed from the state, so Terraform plans to create it again.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// ErrConflict is returned by an update function when the remote object has been changed outside of Terraform.
+var ErrConflict = errors.New("resource has been changed outside of Terraform")
+
 // ResourceMapper defines the interface for mapping between API and Terraform models.
 type ResourceMapper[TfModel any, ApiModel any] interface {
 	// MapAPIToTerraform converts an API model to a Terraform model
@@ -38,6 +47,30 @@ type BaseResource[TfModel any, ApiModel any] struct {
 
 	// Optional nested resource handler
 	NestedHandler any // Type will be cast based on context
+
+	// ReadOnly refuses all create, update and delete operations, see ProviderData.ReadOnly
+	ReadOnly bool
+
+	// Timeouts are the defaults of the resource, overridable by the `timeouts` block
+	Timeouts Timeouts
+
+	// Optional identity of the model, set after create, read and update, see resource.ResourceWithIdentity
+	Identity func(model TfModel) any
+}
+
+// refuseIfReadOnly adds an error diagnostic and returns true if the provider is in read-only mode.
+func (r *BaseResource[TfModel, ApiModel]) refuseIfReadOnly(operation string, diags *diag.Diagnostics) bool {
+	if !r.ReadOnly {
+		return false
+	}
+
+	diags.AddError(
+		"Provider is in read-only mode",
+		"Cannot "+operation+" resource, the provider is configured with read_only = true. "+
+			"Only reads and data sources are allowed.",
+	)
+
+	return true
 }
 
 // ExecuteCreate executes the create operation with proper error handling and mapping.
@@ -49,6 +82,17 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteCreate(
 ) {
 	tflog.Info(ctx, "Starting resource create operation")
 
+	if r.refuseIfReadOnly("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Run the operation with the deadline from the timeouts block
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get plan data
 	var plan TfModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -67,10 +111,7 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteCreate(
 	// Create the resource
 	apiModel, err := createFn(ctx, plan)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating resource",
-			"Could not create resource: "+err.Error(),
-		)
+		addOperationError(&resp.Diagnostics, "Error creating resource", "Could not create resource: ", err)
 
 		return
 	}
@@ -85,6 +126,7 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteCreate(
 	// Set state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	r.setIdentity(ctx, resp.Identity, plan, &resp.Diagnostics)
 
 	tflog.Info(ctx, "Completed resource create operation")
 }
@@ -98,6 +140,13 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteRead(
 ) {
 	tflog.Info(ctx, "Starting resource read operation")
 
+	// Run the operation with the deadline from the timeouts block
+	ctx, cancel := r.withTimeout(ctx, req.State, "read", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state TfModel
 	diags := req.State.Get(ctx, &state)
@@ -109,6 +158,16 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteRead(
 	// Read the resource
 	apiModel, err := readFn(ctx, state)
 	if err != nil {
+		// The remote object is gone, drop it from the state
+		if errors.Is(err, ErrResourceNotFound) {
+			tflog.Warn(ctx, "Resource not found, removing it from state", map[string]any{
+				"error": err.Error(),
+			})
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		// Check if this is a sentinel error (like ErrStateless)
 		// If it's a sentinel error indicating a stateless resource or a similar
 		// known condition that's not an actual error, we can just use the existing state
@@ -124,10 +183,7 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteRead(
 		}
 
 		// It's a real error
-		resp.Diagnostics.AddError(
-			"Error reading resource",
-			"Could not read resource: "+err.Error(),
-		)
+		addOperationError(&resp.Diagnostics, "Error reading resource", "Could not read resource: ", err)
 
 		return
 	}
@@ -142,10 +198,28 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteRead(
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	r.setIdentity(ctx, resp.Identity, state, &resp.Diagnostics)
 
 	tflog.Info(ctx, "Completed resource read operation")
 }
 
+// addOperationError adds the error of a resource operation to the diagnostics.
+// Conflicts and Storage job errors get a dedicated summary, the latter with the job ID to look the job up in the UI.
+func addOperationError(diags *diag.Diagnostics, summary, detailPrefix string, err error) {
+	var jobErr *StorageJobError
+	if errors.Is(err, ErrConflict) {
+		summary = "Conflict with remote changes"
+	} else if errors.As(err, &jobErr) {
+		if jobErr.Err != nil {
+			summary = fmt.Sprintf("Storage job %d did not finish", jobErr.JobID)
+		} else {
+			summary = fmt.Sprintf("Storage job %d failed", jobErr.JobID)
+		}
+	}
+
+	diags.AddError(summary, detailPrefix+err.Error())
+}
+
 // isSentinelError determines if an error is a sentinel error that should be handled specially.
 // Currently this is done by checking for known error messages that indicate
 // a non-problematic condition like a stateless resource.
@@ -165,6 +239,17 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteUpdate(
 ) {
 	tflog.Info(ctx, "Starting resource update operation")
 
+	if r.refuseIfReadOnly("update", &resp.Diagnostics) {
+		return
+	}
+
+	// Run the operation with the deadline from the timeouts block
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get plan and state
 	var plan, state TfModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -202,10 +287,7 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteUpdate(
 		}
 
 		// It's a real error
-		resp.Diagnostics.AddError(
-			"Error updating resource",
-			"Could not update resource: "+err.Error(),
-		)
+		addOperationError(&resp.Diagnostics, "Error updating resource", "Could not update resource: ", err)
 
 		return
 	}
@@ -220,6 +302,7 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteUpdate(
 	// Set state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	r.setIdentity(ctx, resp.Identity, plan, &resp.Diagnostics)
 
 	tflog.Info(ctx, "Completed resource update operation")
 }
@@ -233,6 +316,21 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteDelete(
 ) {
 	tflog.Info(ctx, "Starting resource delete operation")
 
+	if r.refuseIfReadOnly("delete", &resp.Diagnostics) {
+		return
+	}
+
+	if refuseIfProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
+	// Run the operation with the deadline from the timeouts block
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete", &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get current state
 	var state TfModel
 	diags := req.State.Get(ctx, &state)
@@ -254,10 +352,7 @@ func (r *BaseResource[TfModel, ApiModel]) ExecuteDelete(
 		}
 
 		// It's a real error
-		resp.Diagnostics.AddError(
-			"Error deleting resource",
-			"Could not delete resource: "+err.Error(),
-		)
+		addOperationError(&resp.Diagnostics, "Error deleting resource", "Could not delete resource: ", err)
 
 		return
 	}
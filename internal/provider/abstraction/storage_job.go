@@ -0,0 +1,131 @@
+package abstraction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+)
+
+// Storage job statuses, see https://keboola.docs.apiary.io/#reference/jobs.
+const (
+	StorageJobStatusWaiting    = "waiting"
+	StorageJobStatusProcessing = "processing"
+	StorageJobStatusSuccess    = "success"
+	StorageJobStatusError      = "error"
+)
+
+// StorageJobPolling configures how often a Storage job is polled.
+type StorageJobPolling struct {
+	// InitialInterval is the wait before the first poll, it doubles with each further poll.
+	InitialInterval time.Duration
+	// MaxInterval caps a single wait.
+	MaxInterval time.Duration
+}
+
+// DefaultStorageJobPolling returns the polling settings used by WaitForStorageJob.
+func DefaultStorageJobPolling() StorageJobPolling {
+	return StorageJobPolling{
+		InitialInterval: 200 * time.Millisecond,
+		MaxInterval:     5 * time.Second,
+	}
+}
+
+// StorageJobGetter fetches the current state of a Storage job.
+type StorageJobGetter func(ctx context.Context, key keboola.StorageJobKey) (*keboola.StorageJob, error)
+
+// StorageJobError is returned when a Storage job finishes with an error or cannot be waited for.
+type StorageJobError struct {
+	JobID       keboola.StorageJobID
+	Operation   string
+	Message     string
+	ExceptionID string
+	// Err is the cause, if the job was not finished, e.g. the context deadline has been exceeded
+	Err error
+}
+
+// Error returns the job ID, the operation and the error message of the job.
+func (e *StorageJobError) Error() string {
+	msg := fmt.Sprintf("storage job %d", e.JobID)
+	if e.Operation != "" {
+		msg += " (" + e.Operation + ")"
+	}
+
+	if e.Err != nil {
+		return msg + " did not finish: " + e.Err.Error()
+	}
+
+	msg += " failed: " + e.Message
+	if e.ExceptionID != "" {
+		msg += " (exception ID: " + e.ExceptionID + ")"
+	}
+
+	return msg
+}
+
+// Unwrap returns the cause.
+func (e *StorageJobError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForStorageJob polls the job using the API client until it finishes, see PollStorageJob.
+func WaitForStorageJob(
+	ctx context.Context,
+	jobs apiclient.StorageJobs,
+	job *keboola.StorageJob,
+) (*keboola.StorageJob, error) {
+	return PollStorageJob(ctx, jobs.GetStorageJob, job, DefaultStorageJobPolling())
+}
+
+// PollStorageJob polls the job until it finishes and returns the finished job.
+// A failed job results in a *StorageJobError with the job's error message.
+// Polling stops when the context is done, so the deadline of the resource operation is respected.
+func PollStorageJob(
+	ctx context.Context,
+	getJob StorageJobGetter,
+	job *keboola.StorageJob,
+	polling StorageJobPolling,
+) (*keboola.StorageJob, error) {
+	wait := polling.InitialInterval
+	for {
+		switch job.Status {
+		case StorageJobStatusSuccess:
+			return job, nil
+		case StorageJobStatusError:
+			return nil, &StorageJobError{
+				JobID:       job.ID,
+				Operation:   job.OperationName,
+				Message:     job.Error.Message,
+				ExceptionID: job.Error.ExceptionID,
+			}
+		}
+
+		tflog.Debug(ctx, "Waiting for storage job", map[string]any{
+			"job_id":    int(job.ID),
+			"operation": job.OperationName,
+			"status":    job.Status,
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, &StorageJobError{JobID: job.ID, Operation: job.OperationName, Err: ctx.Err()}
+		case <-timer.C:
+		}
+
+		wait = min(wait*2, polling.MaxInterval)
+
+		current, err := getJob(ctx, job.StorageJobKey)
+		if err != nil {
+			return nil, &StorageJobError{JobID: job.ID, Operation: job.OperationName, Err: err}
+		}
+
+		job = current
+	}
+}
@@ -0,0 +1,79 @@
+package abstraction
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DefaultTimeout is used for operations without a per-resource default.
+const DefaultTimeout = 5 * time.Minute
+
+// Timeouts contains the per-resource default timeouts, used when the `timeouts` block does not set them.
+// Zero values fall back to DefaultTimeout.
+type Timeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// TimeoutsBlock returns the `timeouts` block, which must be added to the schema of each resource built on BaseResource.
+// The Terraform model must contain the matching field: Timeouts timeouts.Value `tfsdk:"timeouts"`.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.BlockAll(ctx)
+}
+
+// attributeGetter is implemented by the plan and the state.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// withTimeout returns a context with the deadline of the operation taken from the `timeouts` block.
+func (r *BaseResource[TfModel, ApiModel]) withTimeout(
+	ctx context.Context,
+	source attributeGetter,
+	operation string,
+	diags *diag.Diagnostics,
+) (context.Context, context.CancelFunc) {
+	var value timeouts.Value
+	diags.Append(source.GetAttribute(ctx, path.Root("timeouts"), &value)...)
+	if diags.HasError() {
+		return ctx, func() {}
+	}
+
+	var (
+		timeout      time.Duration
+		timeoutDiags diag.Diagnostics
+	)
+
+	switch operation {
+	case "create":
+		timeout, timeoutDiags = value.Create(ctx, orDefault(r.Timeouts.Create))
+	case "read":
+		timeout, timeoutDiags = value.Read(ctx, orDefault(r.Timeouts.Read))
+	case "update":
+		timeout, timeoutDiags = value.Update(ctx, orDefault(r.Timeouts.Update))
+	default:
+		timeout, timeoutDiags = value.Delete(ctx, orDefault(r.Timeouts.Delete))
+	}
+
+	diags.Append(timeoutDiags...)
+	if diags.HasError() {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+func orDefault(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return DefaultTimeout
+	}
+
+	return timeout
+}
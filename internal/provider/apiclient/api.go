@@ -0,0 +1,344 @@
+package apiclient
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ Client = &API{client: nil}
+
+// API implements the interfaces using the Keboola SDK client.
+// The SDK errors are returned unchanged, so the callers can still inspect them.
+type API struct {
+	client *keboola.AuthorizedAPI
+}
+
+// New wraps the Keboola SDK client.
+func New(client *keboola.AuthorizedAPI) *API {
+	return &API{client: client}
+}
+
+// CreateConfig creates the configuration, with its rows if withRows is set.
+func (a *API) CreateConfig(
+	ctx context.Context,
+	config *keboola.ConfigWithRows,
+	withRows bool,
+) (*keboola.ConfigWithRows, error) {
+	return a.client.CreateConfigRequest(config, withRows).Send(ctx)
+}
+
+// GetConfig gets the configuration without its rows.
+func (a *API) GetConfig(ctx context.Context, key keboola.ConfigKey) (*keboola.Config, error) {
+	return a.client.GetConfigRequest(key).Send(ctx)
+}
+
+// UpdateConfig updates the changed fields of the configuration, all fields are updated if changedFields is nil.
+func (a *API) UpdateConfig(
+	ctx context.Context,
+	config *keboola.ConfigWithRows,
+	changedFields []string,
+) (*keboola.ConfigWithRows, error) {
+	return a.client.UpdateConfigRequest(config, changedFields).Send(ctx)
+}
+
+// RestoreConfig restores the configuration from trash.
+func (a *API) RestoreConfig(ctx context.Context, key keboola.ConfigKey) error {
+	_, err := a.client.RestoreConfigRequest(key).Send(ctx)
+
+	return err
+}
+
+// DeleteConfig moves the configuration to trash, a configuration in trash is deleted permanently.
+func (a *API) DeleteConfig(ctx context.Context, key keboola.ConfigKey) error {
+	return a.client.DeleteConfigRequest(key).SendOrErr(ctx)
+}
+
+// ListConfigRows lists the rows of the configuration.
+func (a *API) ListConfigRows(ctx context.Context, key keboola.ConfigRowKey) ([]*keboola.ConfigRow, error) {
+	rows, err := a.client.ListConfigRowRequest(key).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return *rows, nil
+}
+
+// CreateConfigRow creates the configuration row.
+func (a *API) CreateConfigRow(ctx context.Context, row *keboola.ConfigRow) (*keboola.ConfigRow, error) {
+	return a.client.CreateConfigRowRequest(row).Send(ctx)
+}
+
+// UpdateConfigRow updates the changed fields of the configuration row.
+func (a *API) UpdateConfigRow(
+	ctx context.Context,
+	row *keboola.ConfigRow,
+	changedFields []string,
+) (*keboola.ConfigRow, error) {
+	return a.client.UpdateConfigRowRequest(row, changedFields).Send(ctx)
+}
+
+// DeleteConfigRow deletes the configuration row.
+func (a *API) DeleteConfigRow(ctx context.Context, key keboola.ConfigRowKey) error {
+	return a.client.DeleteConfigRowRequest(key).SendOrErr(ctx)
+}
+
+// ListConfigsAndRows lists the configurations with their rows in the branch, grouped by the component.
+func (a *API) ListConfigsAndRows(
+	ctx context.Context,
+	key keboola.BranchKey,
+) ([]*keboola.ComponentWithConfigs, error) {
+	components, err := a.client.ListConfigsAndRowsFrom(key).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return *components, nil
+}
+
+// GetStorageJob gets the current state of the Storage job.
+func (a *API) GetStorageJob(ctx context.Context, key keboola.StorageJobKey) (*keboola.StorageJob, error) {
+	return a.client.GetStorageJobRequest(key).Send(ctx)
+}
+
+// GetDefaultBranch gets the default branch of the project.
+func (a *API) GetDefaultBranch(ctx context.Context) (*keboola.Branch, error) {
+	return a.client.GetDefaultBranchRequest().Send(ctx)
+}
+
+// GetBranch gets the branch.
+func (a *API) GetBranch(ctx context.Context, key keboola.BranchKey) (*keboola.Branch, error) {
+	return a.client.GetBranchRequest(key).Send(ctx)
+}
+
+// ListBranches lists all branches of the project, including the default one.
+func (a *API) ListBranches(ctx context.Context) ([]*keboola.Branch, error) {
+	branches, err := a.client.ListBranchesRequest().Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return *branches, nil
+}
+
+// CreateBranchAsync starts the Storage job creating the branch.
+func (a *API) CreateBranchAsync(ctx context.Context, branch *keboola.Branch) (*keboola.StorageJob, error) {
+	return a.client.CreateBranchAsyncRequest(branch).Send(ctx)
+}
+
+// UpdateBranch updates the changed fields of the branch.
+func (a *API) UpdateBranch(
+	ctx context.Context,
+	branch *keboola.Branch,
+	changedFields []string,
+) (*keboola.Branch, error) {
+	return a.client.UpdateBranchRequest(branch, changedFields).Send(ctx)
+}
+
+// DeleteBranchAsync starts the Storage job deleting the branch.
+func (a *API) DeleteBranchAsync(ctx context.Context, key keboola.BranchKey) (*keboola.StorageJob, error) {
+	return a.client.DeleteBranchAsyncRequest(key).Send(ctx)
+}
+
+// ListBranchMetadata lists all metadata of the branch.
+func (a *API) ListBranchMetadata(ctx context.Context, key keboola.BranchKey) (keboola.MetadataDetails, error) {
+	return derefDetails(a.client.ListBranchMetadataRequest(key).Send(ctx))
+}
+
+// AppendBranchMetadata appends metadata to the branch.
+func (a *API) AppendBranchMetadata(ctx context.Context, key keboola.BranchKey, metadata keboola.Metadata) error {
+	_, err := a.client.AppendBranchMetadataRequest(key, metadata).Send(ctx)
+
+	return err
+}
+
+// DeleteBranchMetadata deletes a single metadata entry of the branch.
+func (a *API) DeleteBranchMetadata(ctx context.Context, key keboola.BranchKey, metadataID string) error {
+	return a.client.DeleteBranchMetadataRequest(key, metadataID).SendOrErr(ctx)
+}
+
+// ListBucketMetadata lists all metadata of the bucket.
+func (a *API) ListBucketMetadata(ctx context.Context, key keboola.BucketKey) (keboola.MetadataDetails, error) {
+	return derefDetails(a.client.ListBucketMetadataRequest(key).Send(ctx))
+}
+
+// AppendBucketMetadata appends metadata to the bucket.
+func (a *API) AppendBucketMetadata(
+	ctx context.Context,
+	key keboola.BucketKey,
+	provider string,
+	metadata keboola.Metadata,
+) error {
+	_, err := a.client.AppendBucketMetadataRequest(key, provider, metadata).Send(ctx)
+
+	return err
+}
+
+// DeleteBucketMetadata deletes a single metadata entry of the bucket.
+func (a *API) DeleteBucketMetadata(ctx context.Context, key keboola.BucketKey, metadataID string) error {
+	return a.client.DeleteBucketMetadataRequest(key, metadataID).SendOrErr(ctx)
+}
+
+// ListTableMetadata lists all metadata of the table.
+func (a *API) ListTableMetadata(ctx context.Context, key keboola.TableKey) (keboola.MetadataDetails, error) {
+	return derefDetails(a.client.ListTableMetadataRequest(key).Send(ctx))
+}
+
+// AppendTableMetadata appends metadata to the table.
+func (a *API) AppendTableMetadata(
+	ctx context.Context,
+	key keboola.TableKey,
+	provider string,
+	metadata keboola.Metadata,
+) error {
+	_, err := a.client.AppendTableMetadataRequest(key, provider, metadata).Send(ctx)
+
+	return err
+}
+
+// DeleteTableMetadata deletes a single metadata entry of the table.
+func (a *API) DeleteTableMetadata(ctx context.Context, key keboola.TableKey, metadataID string) error {
+	return a.client.DeleteTableMetadataRequest(key, metadataID).SendOrErr(ctx)
+}
+
+// ListColumnMetadata lists all metadata of the table column.
+func (a *API) ListColumnMetadata(
+	ctx context.Context,
+	key keboola.TableKey,
+	column string,
+) (keboola.MetadataDetails, error) {
+	return derefDetails(a.client.ListColumnMetadataRequest(key, column).Send(ctx))
+}
+
+// AppendColumnMetadata appends metadata to the table column.
+func (a *API) AppendColumnMetadata(
+	ctx context.Context,
+	key keboola.TableKey,
+	column, provider string,
+	metadata keboola.Metadata,
+) error {
+	_, err := a.client.AppendColumnMetadataRequest(key, column, provider, metadata).Send(ctx)
+
+	return err
+}
+
+// DeleteColumnMetadata deletes a single metadata entry of the table column.
+func (a *API) DeleteColumnMetadata(ctx context.Context, key keboola.TableKey, column, metadataID string) error {
+	return a.client.DeleteColumnMetadataRequest(key, column, metadataID).SendOrErr(ctx)
+}
+
+// Encrypt encrypts the values of the keys starting with "#" for the component in the project.
+func (a *API) Encrypt(
+	ctx context.Context,
+	projectID int,
+	componentID keboola.ComponentID,
+	values map[string]string,
+) (map[string]string, error) {
+	result, err := a.client.EncryptRequest(projectID, componentID, values).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return *result, nil
+}
+
+// ActivateSchedule creates or updates the schedule of the configuration.
+func (a *API) ActivateSchedule(
+	ctx context.Context,
+	configID keboola.ConfigID,
+	configVersionID string,
+) (*keboola.Schedule, error) {
+	return a.client.ActivateScheduleRequest(configID, configVersionID).Send(ctx)
+}
+
+// GetSchedule gets the schedule.
+func (a *API) GetSchedule(ctx context.Context, key keboola.ScheduleKey) (*keboola.Schedule, error) {
+	return a.client.GetScheduleRequest(key).Send(ctx)
+}
+
+// ListSchedules lists all schedules of the project.
+func (a *API) ListSchedules(ctx context.Context) ([]*keboola.Schedule, error) {
+	schedules, err := a.client.ListSchedulesRequest().Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return *schedules, nil
+}
+
+// DeleteSchedule deletes the schedule.
+func (a *API) DeleteSchedule(ctx context.Context, key keboola.ScheduleKey) error {
+	return a.client.DeleteScheduleRequest(key).SendOrErr(ctx)
+}
+
+// CreateJob queues the job, the returned job is not finished yet.
+func (a *API) CreateJob(ctx context.Context, job JobRequest) (*keboola.QueueJob, error) {
+	builder := a.client.NewCreateJobRequest(job.ComponentID).
+		WithBranch(job.BranchID).
+		WithConfig(job.ConfigID)
+
+	if len(job.RowIDs) > 0 {
+		builder = builder.WithConfigRowIDs(job.RowIDs)
+	}
+
+	if len(job.VariableValues) > 0 {
+		// Sorted by the name, so the request is stable
+		values := keboola.VariableValuesData{Values: make([]keboola.VariableValue, 0, len(job.VariableValues))}
+		for _, name := range slices.Sorted(maps.Keys(job.VariableValues)) {
+			values.Values = append(values.Values, keboola.VariableValue{Name: name, Value: job.VariableValues[name]})
+		}
+		builder = builder.WithVariableValuesData(values)
+	}
+
+	return builder.Build().Send(ctx)
+}
+
+// GetJob gets the current state of the job.
+func (a *API) GetJob(ctx context.Context, key keboola.JobKey) (*keboola.QueueJob, error) {
+	return a.client.GetJobRequest(key).Send(ctx)
+}
+
+// SearchJobs returns the matching jobs, the newest first.
+func (a *API) SearchJobs(ctx context.Context, search JobSearch) ([]*keboola.QueueJob, error) {
+	options := []keboola.SearchJobsOption{keboola.WithSearchJobsSort("createdTime", "desc")}
+	if search.BranchID != 0 {
+		options = append(options, keboola.WithSearchJobsBranch(search.BranchID))
+	}
+	if search.ComponentID != "" {
+		options = append(options, keboola.WithSearchJobsComponent(search.ComponentID))
+	}
+	if search.ConfigID != "" {
+		options = append(options, keboola.WithSearchJobsConfig(search.ConfigID))
+	}
+	for _, status := range search.Statuses {
+		options = append(options, keboola.WithSearchJobsStatus(status))
+	}
+	if !search.CreatedFrom.IsZero() {
+		options = append(options, keboola.WithSearchJobsCreatedTimeFrom(search.CreatedFrom))
+	}
+	if !search.CreatedTo.IsZero() {
+		options = append(options, keboola.WithSearchJobsCreatedTimeTo(search.CreatedTo))
+	}
+	if search.Limit > 0 {
+		options = append(options, keboola.WithSearchJobsLimit(search.Limit))
+	}
+
+	jobs, err := a.client.SearchJobsRequest(options...).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return *jobs, nil
+}
+
+// derefDetails returns the metadata details of a list request.
+func derefDetails(details *keboola.MetadataDetails, err error) (keboola.MetadataDetails, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return *details, nil
+}
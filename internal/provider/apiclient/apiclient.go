@@ -0,0 +1,140 @@
+// Package apiclient contains narrow per-domain interfaces of the Keboola API used by the resources.
+// The resources depend on these interfaces instead of the SDK client, so their logic can be tested
+// with in-memory doubles, see the internal/test/double package.
+package apiclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// Client provides all the interfaces, it is implemented by API.
+type Client interface {
+	Configs
+	Branches
+	Metadata
+	Encryption
+	Schedules
+	Jobs
+}
+
+// Configs manages component configurations and their rows.
+type Configs interface {
+	CreateConfig(ctx context.Context, config *keboola.ConfigWithRows, withRows bool) (*keboola.ConfigWithRows, error)
+	GetConfig(ctx context.Context, key keboola.ConfigKey) (*keboola.Config, error)
+	UpdateConfig(
+		ctx context.Context,
+		config *keboola.ConfigWithRows,
+		changedFields []string,
+	) (*keboola.ConfigWithRows, error)
+	// RestoreConfig restores the configuration from trash.
+	RestoreConfig(ctx context.Context, key keboola.ConfigKey) error
+	// DeleteConfig moves the configuration to trash, a configuration in trash is deleted permanently.
+	DeleteConfig(ctx context.Context, key keboola.ConfigKey) error
+	// ListConfigRows lists the rows of the configuration identified by the key, the row ID is ignored.
+	ListConfigRows(ctx context.Context, key keboola.ConfigRowKey) ([]*keboola.ConfigRow, error)
+	// CreateConfigRow creates the row, the ID is generated if it is empty.
+	CreateConfigRow(ctx context.Context, row *keboola.ConfigRow) (*keboola.ConfigRow, error)
+	UpdateConfigRow(ctx context.Context, row *keboola.ConfigRow, changedFields []string) (*keboola.ConfigRow, error)
+	DeleteConfigRow(ctx context.Context, key keboola.ConfigRowKey) error
+	// ListConfigsAndRows lists the configurations with their rows in the branch, grouped by the component.
+	// The configurations in trash are not listed.
+	ListConfigsAndRows(ctx context.Context, key keboola.BranchKey) ([]*keboola.ComponentWithConfigs, error)
+}
+
+// StorageJobs reads the asynchronous Storage jobs.
+type StorageJobs interface {
+	GetStorageJob(ctx context.Context, key keboola.StorageJobKey) (*keboola.StorageJob, error)
+}
+
+// Branches manages development branches.
+// Creating and deleting a branch starts a Storage job, see abstraction.WaitForStorageJob.
+type Branches interface {
+	StorageJobs
+	GetDefaultBranch(ctx context.Context) (*keboola.Branch, error)
+	GetBranch(ctx context.Context, key keboola.BranchKey) (*keboola.Branch, error)
+	ListBranches(ctx context.Context) ([]*keboola.Branch, error)
+	CreateBranchAsync(ctx context.Context, branch *keboola.Branch) (*keboola.StorageJob, error)
+	UpdateBranch(ctx context.Context, branch *keboola.Branch, changedFields []string) (*keboola.Branch, error)
+	DeleteBranchAsync(ctx context.Context, key keboola.BranchKey) (*keboola.StorageJob, error)
+}
+
+// Metadata manages metadata of branches, buckets, tables and table columns.
+type Metadata interface {
+	ListBranchMetadata(ctx context.Context, key keboola.BranchKey) (keboola.MetadataDetails, error)
+	AppendBranchMetadata(ctx context.Context, key keboola.BranchKey, metadata keboola.Metadata) error
+	DeleteBranchMetadata(ctx context.Context, key keboola.BranchKey, metadataID string) error
+
+	ListBucketMetadata(ctx context.Context, key keboola.BucketKey) (keboola.MetadataDetails, error)
+	AppendBucketMetadata(ctx context.Context, key keboola.BucketKey, provider string, metadata keboola.Metadata) error
+	DeleteBucketMetadata(ctx context.Context, key keboola.BucketKey, metadataID string) error
+
+	ListTableMetadata(ctx context.Context, key keboola.TableKey) (keboola.MetadataDetails, error)
+	AppendTableMetadata(ctx context.Context, key keboola.TableKey, provider string, metadata keboola.Metadata) error
+	DeleteTableMetadata(ctx context.Context, key keboola.TableKey, metadataID string) error
+
+	ListColumnMetadata(ctx context.Context, key keboola.TableKey, column string) (keboola.MetadataDetails, error)
+	AppendColumnMetadata(
+		ctx context.Context,
+		key keboola.TableKey,
+		column, provider string,
+		metadata keboola.Metadata,
+	) error
+	DeleteColumnMetadata(ctx context.Context, key keboola.TableKey, column, metadataID string) error
+}
+
+// Encryption encrypts values using the Encryption API.
+type Encryption interface {
+	// Encrypt encrypts the values of the keys starting with "#" for the component in the project.
+	Encrypt(
+		ctx context.Context,
+		projectID int,
+		componentID keboola.ComponentID,
+		values map[string]string,
+	) (map[string]string, error)
+}
+
+// Schedules manages schedules of the scheduler component configurations.
+type Schedules interface {
+	// ActivateSchedule creates or updates the schedule of the configuration, an empty version means the latest one.
+	ActivateSchedule(ctx context.Context, configID keboola.ConfigID, configVersionID string) (*keboola.Schedule, error)
+	GetSchedule(ctx context.Context, key keboola.ScheduleKey) (*keboola.Schedule, error)
+	ListSchedules(ctx context.Context) ([]*keboola.Schedule, error)
+	DeleteSchedule(ctx context.Context, key keboola.ScheduleKey) error
+}
+
+// JobRequest defines a Job Queue job running a configuration.
+type JobRequest struct {
+	BranchID    keboola.BranchID
+	ComponentID keboola.ComponentID
+	ConfigID    keboola.ConfigID
+	// RowIDs limits the job to the rows, all enabled rows run if empty.
+	RowIDs []keboola.RowID
+	// VariableValues are the values of the configuration variables, by the variable name.
+	VariableValues map[string]string
+}
+
+// JobSearch filters the Job Queue jobs, the zero value of a field matches any job.
+type JobSearch struct {
+	BranchID    keboola.BranchID
+	ComponentID keboola.ComponentID
+	ConfigID    keboola.ConfigID
+	// Statuses matches a job with any of the statuses.
+	Statuses []string
+	// CreatedFrom and CreatedTo limit the creation time of the jobs.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Limit is the maximum number of the jobs, the API default applies if zero.
+	Limit int
+}
+
+// Jobs runs configurations using the Job Queue API.
+// The jobs are asynchronous, see abstraction.WaitForQueueJob.
+type Jobs interface {
+	CreateJob(ctx context.Context, job JobRequest) (*keboola.QueueJob, error)
+	GetJob(ctx context.Context, key keboola.JobKey) (*keboola.QueueJob, error)
+	// SearchJobs returns the matching jobs, the newest first.
+	SearchJobs(ctx context.Context, search JobSearch) ([]*keboola.QueueJob, error)
+}
@@ -0,0 +1,138 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/keboola/go-utils/pkg/orderedmap"
+)
+
+// ErrInvalidJSONPointer is returned for a JSON pointer not conforming to RFC 6901.
+var ErrInvalidJSONPointer = errors.New("invalid JSON pointer")
+
+// ParseJSONPointer splits an RFC 6901 JSON pointer, e.g. /parameters/authorization, into unescaped tokens.
+// The empty pointer, referring to the whole document, is not allowed.
+func ParseJSONPointer(pointer string) ([]string, error) {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf(`%w "%s": it must start with "/"`, ErrInvalidJSONPointer, pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		// "~1" must be replaced before "~0", so "~01" results in "~1"
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// CopyJSONPointers copies the values addressed by the pointers from the source to the target document.
+// A value missing in the source is removed from the target, so both documents are equal at the pointers.
+func CopyJSONPointers(source, target *orderedmap.OrderedMap, pointers []string) error {
+	for _, pointer := range pointers {
+		tokens, err := ParseJSONPointer(pointer)
+		if err != nil {
+			return err
+		}
+
+		if value, found := getJSONValue(source, tokens); found {
+			setJSONValue(target, tokens, value)
+		} else {
+			deleteJSONValue(target, tokens)
+		}
+	}
+
+	return nil
+}
+
+// getJSONValue returns the value at the path, numeric tokens address array items.
+func getJSONValue(document any, tokens []string) (any, bool) {
+	current := document
+	for _, token := range tokens {
+		switch v := current.(type) {
+		case *orderedmap.OrderedMap:
+			value, found := v.Get(token)
+			if !found {
+				return nil, false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, false
+			}
+			current = v[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// setJSONValue sets the value at the path, missing parent objects are created.
+// The value is not set if a parent is a scalar or the array index is out of range.
+func setJSONValue(document *orderedmap.OrderedMap, tokens []string, value any) {
+	var current any = document
+	for i, token := range tokens {
+		last := i == len(tokens)-1
+
+		switch v := current.(type) {
+		case *orderedmap.OrderedMap:
+			if last {
+				v.Set(token, value)
+
+				return
+			}
+
+			next, found := v.Get(token)
+			if !found {
+				next = orderedmap.New()
+				v.Set(token, next)
+			}
+			current = next
+		case []any:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return
+			}
+
+			if last {
+				v[index] = value
+
+				return
+			}
+			current = v[index]
+		default:
+			return
+		}
+	}
+}
+
+// deleteJSONValue removes the value at the path, if it exists.
+func deleteJSONValue(document *orderedmap.OrderedMap, tokens []string) {
+	parentTokens, token := tokens[:len(tokens)-1], tokens[len(tokens)-1]
+
+	parent, found := getJSONValue(document, parentTokens)
+	if !found {
+		return
+	}
+
+	switch v := parent.(type) {
+	case *orderedmap.OrderedMap:
+		v.Delete(token)
+	case []any:
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index >= len(v) {
+			return
+		}
+
+		// The array is replaced in its parent, as removing an item changes its length
+		items := make([]any, 0, len(v)-1)
+		items = append(items, v[:index]...)
+		items = append(items, v[index+1:]...)
+		setJSONValue(document, parentTokens, items)
+	}
+}
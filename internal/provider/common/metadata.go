@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// ErrNoMetadataID is returned when the metadata entry cannot be found after it has been appended.
+var ErrNoMetadataID = errors.New("failed to find metadata id")
+
+// MetadataTarget abstracts an object which metadata can be attached to (branch, bucket, table, column).
+type MetadataTarget interface {
+	// Append adds or overwrites metadata entries of the target under the given provider.
+	Append(ctx context.Context, provider string, metadata keboola.Metadata) error
+
+	// List returns all metadata entries of the target.
+	List(ctx context.Context) (keboola.MetadataDetails, error)
+
+	// Delete removes a single metadata entry by its ID.
+	Delete(ctx context.Context, metadataID string) error
+}
+
+// AppendMetadata writes a single key/value pair to the target and returns the stored entry.
+// The API does not return the ID of the appended entry, so the metadata is listed afterwards to find it.
+func AppendMetadata(
+	ctx context.Context,
+	target MetadataTarget,
+	provider, key, value string,
+) (*keboola.MetadataDetail, error) {
+	metadata := make(keboola.Metadata)
+	metadata[key] = value
+
+	if err := target.Append(ctx, provider, metadata); err != nil {
+		return nil, fmt.Errorf("failed to create metadata: %w", err)
+	}
+
+	details, err := target.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list metadata: %w", err)
+	}
+
+	detail := FindMetadata(details, provider, key)
+	if detail == nil {
+		return nil, ErrNoMetadataID
+	}
+
+	return detail, nil
+}
+
+// FindMetadata returns the entry with the given key, or nil if there is none.
+// An empty provider matches entries of any provider.
+// If more entries match, the last one wins, as in keboola.MetadataDetails.ToMap.
+func FindMetadata(details keboola.MetadataDetails, provider, key string) *keboola.MetadataDetail {
+	var found *keboola.MetadataDetail
+	for _, detail := range details {
+		if detail.Key != key {
+			continue
+		}
+
+		if provider != "" && detail.Provider != provider {
+			continue
+		}
+
+		found = &detail
+	}
+
+	return found
+}
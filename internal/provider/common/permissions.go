@@ -0,0 +1,153 @@
+package common
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// SchedulerComponentID is the component which schedules are stored under.
+const SchedulerComponentID = "keboola.scheduler"
+
+// permissionCheck returns a reason why the token cannot manage a resource type, or an empty string.
+type permissionCheck func(token *keboola.Token) string
+
+// permissionChecks maps resource type names to the permissions required to manage them.
+var permissionChecks = map[string]permissionCheck{ //nolint: gochecknoglobals
+	"keboola_branch":                  requireMaster,
+	"keboola_branch_metadata":         requireMaster,
+	"keboola_component_configuration": requireComponentAccess,
+	"keboola_encryption":              func(_ *keboola.Token) string { return "" },
+	"keboola_job":                     requireComponentAccess,
+	"keboola_scheduler":               requireComponent(SchedulerComponentID),
+	"keboola_storage_metadata":        requireBucketAccess,
+	"keboola_storage_token":           requireManageTokens,
+}
+
+// PermissionCheckedResourceTypes returns the names of the resource types that can be checked.
+func PermissionCheckedResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(permissionChecks))
+	for resourceType := range permissionChecks {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	return resourceTypes
+}
+
+// MissingPermissions inspects the token and returns, for each resource type the token cannot manage,
+// the reason why. Unknown resource types are ignored, see PermissionCheckedResourceTypes.
+func MissingPermissions(token *keboola.Token, resourceTypes []string) map[string]string {
+	missing := make(map[string]string)
+	for _, resourceType := range resourceTypes {
+		check, ok := permissionChecks[resourceType]
+		if !ok {
+			continue
+		}
+
+		if reason := check(token); reason != "" {
+			missing[resourceType] = reason
+		}
+	}
+
+	return missing
+}
+
+// CheckPermissions reports, as a single diagnostic, all resource types the token cannot manage.
+// The attribute path points to the provider setting listing the resource types.
+func CheckPermissions(attrPath path.Path, token *keboola.Token, resourceTypes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, resourceType := range resourceTypes {
+		if _, ok := permissionChecks[resourceType]; !ok {
+			diags.AddAttributeError(
+				attrPath,
+				"Unknown Resource Type",
+				fmt.Sprintf(
+					"Resource type '%s' cannot be checked, supported resource types are: %s.",
+					resourceType,
+					strings.Join(PermissionCheckedResourceTypes(), ", "),
+				),
+			)
+		}
+	}
+
+	missing := MissingPermissions(token, resourceTypes)
+	if len(missing) == 0 {
+		return diags
+	}
+
+	lines := make([]string, 0, len(missing))
+	for resourceType, reason := range missing {
+		lines = append(lines, fmt.Sprintf("  - %s: %s", resourceType, reason))
+	}
+	sort.Strings(lines)
+
+	diags.AddAttributeError(
+		attrPath,
+		"Insufficient Token Permissions",
+		fmt.Sprintf(
+			"The token %s (%s) of project %d cannot manage the following resource types:\n%s\n"+
+				"Use a token with the required permissions or remove the resource types from the check.",
+			token.ID,
+			token.Description,
+			token.ProjectID(),
+			strings.Join(lines, "\n"),
+		),
+	)
+
+	return diags
+}
+
+func requireMaster(token *keboola.Token) string {
+	if token.IsMaster {
+		return ""
+	}
+
+	return "a master token is required"
+}
+
+func requireManageTokens(token *keboola.Token) string {
+	if token.IsMaster || token.CanManageTokens {
+		return ""
+	}
+
+	return "the token cannot manage tokens"
+}
+
+func requireComponentAccess(token *keboola.Token) string {
+	if token.IsMaster || len(token.ComponentAccess) > 0 {
+		return ""
+	}
+
+	return "the token has no access to any component"
+}
+
+func requireComponent(componentID string) permissionCheck {
+	return func(token *keboola.Token) string {
+		if token.IsMaster || slices.Contains(token.ComponentAccess, componentID) {
+			return ""
+		}
+
+		return "the token has no access to the " + componentID + " component"
+	}
+}
+
+func requireBucketAccess(token *keboola.Token) string {
+	if token.IsMaster || token.CanManageBuckets {
+		return ""
+	}
+
+	for _, permission := range token.BucketPermissions {
+		if permission == keboola.BucketPermissionWrite {
+			return ""
+		}
+	}
+
+	return "the token cannot manage buckets and has no write access to any bucket"
+}
@@ -0,0 +1,142 @@
+package currenttoken
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DataSource{token: nil}
+	_ datasource.DataSourceWithConfigure = &DataSource{token: nil}
+)
+
+// DataSource is the current token data source implementation.
+type DataSource struct {
+	// Token verified during provider configuration
+	token *keboola.Token
+}
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() *DataSource {
+	return &DataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_token"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Details of the token the provider is configured with.",
+		MarkdownDescription: "Details of the token the provider is configured with.",
+		DeprecationMessage:  "",
+		Blocks:              map[string]schema.Block{},
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Token ID.",
+				Computed:    true,
+			},
+			"project_id": schema.Int64Attribute{
+				Description: "ID of the project the token belongs to.",
+				Computed:    true,
+			},
+			"project_name": schema.StringAttribute{
+				Description: "Name of the project the token belongs to.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Token description.",
+				Computed:    true,
+			},
+			"is_master": schema.BoolAttribute{
+				Description: "Whether the token is a master token.",
+				Computed:    true,
+			},
+			"is_admin": schema.BoolAttribute{
+				Description: "Whether the token is owned by an admin of the project.",
+				Computed:    true,
+			},
+			"owner_id": schema.Int64Attribute{
+				Description: "ID of the admin owning the token. Set only for admin tokens.",
+				Computed:    true,
+			},
+			"owner_name": schema.StringAttribute{
+				Description: "Name of the admin owning the token. Set only for admin tokens.",
+				Computed:    true,
+			},
+			"can_manage_buckets": schema.BoolAttribute{
+				Description: "Whether the token can create, modify and delete buckets.",
+				Computed:    true,
+			},
+			"can_manage_tokens": schema.BoolAttribute{
+				Description: "Whether the token can manage other tokens.",
+				Computed:    true,
+			},
+			"can_read_all_file_uploads": schema.BoolAttribute{
+				Description: "Whether the token can read all file uploads of the project.",
+				Computed:    true,
+			},
+			"expires": schema.StringAttribute{
+				Description: "Timestamp of the token expiration. Not set if the token never expires.",
+				Computed:    true,
+			},
+			"bucket_permissions": schema.MapAttribute{
+				Description: "Map of bucket IDs to permissions of the token.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"component_access": schema.ListAttribute{
+				Description: "IDs of the components the token can access.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider verified token to the data source.
+func (d *DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+	d.token = providerData.GetToken()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading current token data source")
+
+	// The token was verified in provider Configure, no API call is needed
+	if d.token == nil {
+		resp.Diagnostics.AddError(
+			"Error reading current token",
+			"The provider has not been configured with a verified token.",
+		)
+
+		return
+	}
+
+	var state Model
+	diags := mapTokenToModel(ctx, d.token, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,53 @@
+package currenttoken
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// mapTokenToModel converts the verified keboola.Token to the data source model.
+func mapTokenToModel(ctx context.Context, token *keboola.Token, model *Model) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(token.ID)
+	model.ProjectID = types.Int64Value(int64(token.ProjectID()))
+	model.ProjectName = types.StringValue(token.ProjectName())
+	model.Description = types.StringValue(token.Description)
+	model.IsMaster = types.BoolValue(token.IsMaster)
+	model.CanManageBuckets = types.BoolValue(token.CanManageBuckets)
+	model.CanManageTokens = types.BoolValue(token.CanManageTokens)
+	model.CanReadAllFileUploads = types.BoolValue(token.CanReadAllFileUploads)
+
+	// Only master tokens are owned by an admin
+	model.IsAdmin = types.BoolValue(token.Admin != nil)
+	model.OwnerID = types.Int64Null()
+	model.OwnerName = types.StringNull()
+	if token.Admin != nil {
+		model.OwnerID = types.Int64Value(int64(token.Admin.ID))
+		model.OwnerName = types.StringValue(token.Admin.Name)
+	}
+
+	model.Expires = types.StringNull()
+	if token.Expires != nil {
+		model.Expires = types.StringValue(token.Expires.UTC().String())
+	}
+
+	permissions := make(map[string]string, len(token.BucketPermissions))
+	for bucketID, permission := range token.BucketPermissions {
+		permissions[bucketID.String()] = string(permission)
+	}
+	permissionsValue, mapDiags := types.MapValueFrom(ctx, types.StringType, permissions)
+	diags.Append(mapDiags...)
+	model.BucketPermissions = permissionsValue
+
+	components := make([]string, 0, len(token.ComponentAccess))
+	components = append(components, token.ComponentAccess...)
+	componentsValue, listDiags := types.ListValueFrom(ctx, types.StringType, components)
+	diags.Append(listDiags...)
+	model.ComponentAccess = componentsValue
+
+	return diags
+}
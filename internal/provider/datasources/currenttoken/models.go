@@ -0,0 +1,23 @@
+package currenttoken
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model defines the current token data source model.
+type Model struct {
+	ID                    types.String `tfsdk:"id"`
+	ProjectID             types.Int64  `tfsdk:"project_id"`
+	ProjectName           types.String `tfsdk:"project_name"`
+	Description           types.String `tfsdk:"description"`
+	IsMaster              types.Bool   `tfsdk:"is_master"`
+	IsAdmin               types.Bool   `tfsdk:"is_admin"`
+	OwnerID               types.Int64  `tfsdk:"owner_id"`
+	OwnerName             types.String `tfsdk:"owner_name"`
+	CanManageBuckets      types.Bool   `tfsdk:"can_manage_buckets"`
+	CanManageTokens       types.Bool   `tfsdk:"can_manage_tokens"`
+	CanReadAllFileUploads types.Bool   `tfsdk:"can_read_all_file_uploads"`
+	Expires               types.String `tfsdk:"expires"`
+	BucketPermissions     types.Map    `tfsdk:"bucket_permissions"`
+	ComponentAccess       types.List   `tfsdk:"component_access"`
+}
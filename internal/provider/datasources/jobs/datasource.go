@@ -0,0 +1,287 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+const (
+	// defaultLimit is the number of the listed jobs if the limit is not specified.
+	defaultLimit = 100
+	// maxLimit is the maximum number of the jobs returned by the Job Queue API at once.
+	maxLimit = 500
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DataSource{jobs: nil, branches: nil}
+	_ datasource.DataSourceWithConfigure = &DataSource{jobs: nil, branches: nil}
+)
+
+// DataSource searches the jobs of the Job Queue.
+type DataSource struct {
+	jobs     apiclient.Jobs
+	branches apiclient.Branches
+}
+
+// NewDataSource is a helper function to simplify the provider implementation.
+func NewDataSource() *DataSource {
+	return &DataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jobs"
+}
+
+// Schema defines the schema for the data source.
+func (d *DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the Job Queue jobs of a branch, the newest first. " +
+			"E.g. the first job of a configuration with limit 1 is its latest run.",
+		MarkdownDescription: "Searches the Job Queue jobs of a branch, the newest first. " +
+			"E.g. the first job of a configuration with `limit = 1` is its latest run.",
+		DeprecationMessage: "",
+		Blocks:             map[string]schema.Block{},
+		Attributes: map[string]schema.Attribute{
+			"branch_id": schema.Int64Attribute{
+				Description: "Id of the branch. If not specified, then default branch will be used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"component_id": schema.StringAttribute{
+				Description: "Id of the component. If specified, only its jobs are listed.",
+				Optional:    true,
+			},
+			"configuration_id": schema.StringAttribute{
+				Description: "Id of the configuration. If specified, only its jobs are listed.",
+				Optional:    true,
+			},
+			"statuses": schema.ListAttribute{
+				Description: "Statuses of the jobs, e.g. success or error. If specified, only jobs with any of them are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: "RFC 3339 timestamp. If specified, only jobs created at or after it are listed.",
+				Optional:    true,
+			},
+			"created_before": schema.StringAttribute{
+				Description: "RFC 3339 timestamp. If specified, only jobs created before it are listed.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf(
+					"Maximum number of the listed jobs, from 1 to %d. Defaults to %d.", maxLimit, defaultLimit,
+				),
+				Optional: true,
+			},
+			"jobs": schema.ListNestedAttribute{
+				Description: "Jobs sorted by the creation time, the newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Id of the job.",
+							Computed:    true,
+						},
+						"branch_id": schema.Int64Attribute{
+							Description: "Id of the branch.",
+							Computed:    true,
+						},
+						"component_id": schema.StringAttribute{
+							Description: "Id of the component.",
+							Computed:    true,
+						},
+						"configuration_id": schema.StringAttribute{
+							Description: "Id of the configuration.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the job, e.g. processing, success or error.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "URL of the job in the Job Queue API.",
+							Computed:    true,
+						},
+						"created_time": schema.StringAttribute{
+							Description: "RFC 3339 timestamp when the job was created, it can be used as created_after or created_before.",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "RFC 3339 timestamp when the job started, null if it has not started yet.",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "RFC 3339 timestamp when the job ended, null if it has not ended yet.",
+							Computed:    true,
+						},
+						"duration_seconds": schema.Int64Attribute{
+							Description: "Duration of the job in seconds, null if it has not ended yet.",
+							Computed:    true,
+						},
+						"error_message": schema.StringAttribute{
+							Description: "Result message of a job which has not succeeded, null otherwise.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured clients to the data source.
+func (d *DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+	d.jobs = providerData.Jobs
+	d.branches = providerData.Branches
+}
+
+// Read searches the jobs.
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading jobs data source")
+
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the default branch if not specified
+	if model.BranchID.IsNull() || model.BranchID.IsUnknown() {
+		branch, err := d.branches.GetDefaultBranch(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading jobs", "could not get default branch: "+err.Error())
+
+			return
+		}
+		model.BranchID = types.Int64Value(int64(branch.ID))
+	}
+
+	search, diags := jobSearch(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobs, err := d.jobs.SearchJobs(ctx, search)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading jobs", "could not search jobs: "+err.Error())
+
+		return
+	}
+
+	model.Jobs = make([]JobModel, 0, len(jobs))
+	for _, job := range jobs {
+		model.Jobs = append(model.Jobs, mapJobToModel(job))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// jobSearch converts the configured filters to the search, the invalid filters are reported as attribute errors.
+func jobSearch(ctx context.Context, model Model) (apiclient.JobSearch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	search := apiclient.JobSearch{
+		BranchID:    keboola.BranchID(model.BranchID.ValueInt64()),
+		ComponentID: keboola.ComponentID(model.ComponentID.ValueString()),
+		ConfigID:    keboola.ConfigID(model.ConfigID.ValueString()),
+		Statuses:    nil,
+		CreatedFrom: time.Time{},
+		CreatedTo:   time.Time{},
+		Limit:       defaultLimit,
+	}
+
+	if !model.Statuses.IsNull() {
+		diags.Append(model.Statuses.ElementsAs(ctx, &search.Statuses, false)...)
+	}
+
+	search.CreatedFrom = parseTime(model.CreatedAfter, path.Root("created_after"), &diags)
+	search.CreatedTo = parseTime(model.CreatedBefore, path.Root("created_before"), &diags)
+
+	if !model.Limit.IsNull() {
+		limit := model.Limit.ValueInt64()
+		if limit < 1 || limit > maxLimit {
+			diags.AddAttributeError(
+				path.Root("limit"),
+				"Invalid limit",
+				fmt.Sprintf("limit must be from 1 to %d, got %d", maxLimit, limit),
+			)
+		}
+		search.Limit = int(limit)
+	}
+
+	return search, diags
+}
+
+// parseTime parses the optional RFC 3339 timestamp, the null value results in the zero time.
+func parseTime(value types.String, attribute path.Path, diags *diag.Diagnostics) time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attribute, "Invalid timestamp", "expected an RFC 3339 timestamp: "+err.Error())
+	}
+
+	return parsed
+}
+
+func mapJobToModel(job *keboola.QueueJob) JobModel {
+	item := JobModel{
+		ID:              types.StringValue(job.ID.String()),
+		BranchID:        types.Int64Value(int64(job.BranchID)),
+		ComponentID:     types.StringValue(job.ComponentID.String()),
+		ConfigID:        types.StringValue(job.ConfigID.String()),
+		Status:          types.StringValue(job.Status),
+		URL:             types.StringValue(job.URL),
+		CreatedTime:     types.StringValue(job.CreateTime.UTC().Format(time.RFC3339)),
+		StartTime:       types.StringNull(),
+		EndTime:         types.StringNull(),
+		DurationSeconds: types.Int64Null(),
+		ErrorMessage:    types.StringNull(),
+	}
+
+	if job.StartTime != nil {
+		item.StartTime = types.StringValue(job.StartTime.UTC().Format(time.RFC3339))
+	}
+
+	if job.EndTime != nil {
+		item.EndTime = types.StringValue(job.EndTime.UTC().Format(time.RFC3339))
+	}
+
+	if job.StartTime != nil && job.EndTime != nil {
+		item.DurationSeconds = types.Int64Value(int64(job.EndTime.Sub(job.StartTime.Time).Seconds()))
+	}
+
+	// Only a job which has finished without success has an error
+	failed := job.Status != abstraction.QueueJobStatusSuccess && job.Status != abstraction.QueueJobStatusWarning
+	if abstraction.IsQueueJobFinished(job) && failed {
+		item.ErrorMessage = types.StringValue(job.Result.Message)
+	}
+
+	return item
+}
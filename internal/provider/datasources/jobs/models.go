@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model defines the jobs data source model.
+type Model struct {
+	BranchID      types.Int64  `tfsdk:"branch_id"`
+	ComponentID   types.String `tfsdk:"component_id"`
+	ConfigID      types.String `tfsdk:"configuration_id"`
+	Statuses      types.List   `tfsdk:"statuses"`
+	CreatedAfter  types.String `tfsdk:"created_after"`
+	CreatedBefore types.String `tfsdk:"created_before"`
+	Limit         types.Int64  `tfsdk:"limit"`
+	Jobs          []JobModel   `tfsdk:"jobs"`
+}
+
+// JobModel is a single listed job.
+type JobModel struct {
+	ID              types.String `tfsdk:"id"`
+	BranchID        types.Int64  `tfsdk:"branch_id"`
+	ComponentID     types.String `tfsdk:"component_id"`
+	ConfigID        types.String `tfsdk:"configuration_id"`
+	Status          types.String `tfsdk:"status"`
+	URL             types.String `tfsdk:"url"`
+	CreatedTime     types.String `tfsdk:"created_time"`
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	ErrorMessage    types.String `tfsdk:"error_message"`
+}
@@ -0,0 +1,76 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ConfigID{}
+
+// ConfigID builds the compound ID of a keboola_component_configuration, the inverse of parse_config_id.
+type ConfigID struct{}
+
+// NewConfigID is a helper function to simplify the provider implementation.
+func NewConfigID() function.Function {
+	return &ConfigID{}
+}
+
+// Metadata returns the function name.
+func (f *ConfigID) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "config_id"
+}
+
+// Definition defines the parameters and the return type.
+func (f *ConfigID) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a configuration ID",
+		MarkdownDescription: "Builds the `id` of a `keboola_component_configuration` " +
+			"in the `branchId/componentId/configId` format.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "branch_id",
+				MarkdownDescription: "ID of the branch.",
+			},
+			function.StringParameter{
+				Name:                "component_id",
+				MarkdownDescription: "ID of the component, e.g. `ex-generic-v2`.",
+			},
+			function.StringParameter{
+				Name:                "configuration_id",
+				MarkdownDescription: "ID of the configuration.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the ID, the parts are validated the same way as by parse_config_id.
+func (f *ConfigID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		branchID              int64
+		componentID, configID string
+	)
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &branchID, &componentID, &configID))
+	if resp.Error != nil {
+		return
+	}
+
+	model := configuration.ConfigModel{
+		BranchID:    types.Int64Value(branchID),
+		ComponentID: types.StringValue(componentID),
+		ConfigID:    types.StringValue(configID),
+	}
+	id := configuration.GetConfigModelID(&model)
+	if _, err := configuration.ParseConfigModelID(id); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, id))
+}
@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &IsEncrypted{}
+
+// encryptedValueRegexp matches the prefix of values encrypted by the Encryption API, e.g. KBC::ProjectSecure::.
+var encryptedValueRegexp = regexp.MustCompile(`^KBC::[A-Za-z]+::`)
+
+// IsEncrypted checks whether a value is encrypted by the Encryption API.
+type IsEncrypted struct{}
+
+// NewIsEncrypted is a helper function to simplify the provider implementation.
+func NewIsEncrypted() function.Function {
+	return &IsEncrypted{}
+}
+
+// Metadata returns the function name.
+func (f *IsEncrypted) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_encrypted"
+}
+
+// Definition defines the parameters and the return type.
+func (f *IsEncrypted) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a value is encrypted",
+		MarkdownDescription: "Returns true if the value is a ciphertext of the Encryption API, " +
+			"e.g. `KBC::ProjectSecure::...` produced by `keboola_encryption`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "Value to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks the prefix of the value.
+func (f *IsEncrypted) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, encryptedValueRegexp.MatchString(value)))
+}
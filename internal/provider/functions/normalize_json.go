@@ -0,0 +1,69 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &NormalizeJSON{}
+
+// NormalizeJSON formats a JSON object the same way as the configuration content is stored in the state.
+type NormalizeJSON struct{}
+
+// NewNormalizeJSON is a helper function to simplify the provider implementation.
+func NewNormalizeJSON() function.Function {
+	return &NormalizeJSON{}
+}
+
+// Metadata returns the function name.
+func (f *NormalizeJSON) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_json"
+}
+
+// Definition defines the parameters and the return type.
+func (f *NormalizeJSON) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a JSON object",
+		MarkdownDescription: "Returns the JSON object in the canonical form of the `configuration` " +
+			"of a `keboola_component_configuration` in the state: compact, with the order of the keys kept.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "JSON object.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run parses and serializes the object.
+func (f *NormalizeJSON) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	content, err := common.ParseJSON(types.StringValue(value))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "The value must be a JSON object: "+err.Error())
+
+		return
+	}
+
+	// The same serialization as of the configuration in the mapper
+	normalized, err := json.Marshal(content)
+	if err != nil {
+		resp.Error = function.NewFuncError("Could not serialize the JSON object: " + err.Error())
+
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(normalized)))
+}
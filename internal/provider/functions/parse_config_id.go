@@ -0,0 +1,85 @@
+// Package functions contains the provider-defined functions, supported in Terraform 1.8 and later.
+// They are called as provider::keboola::<name>(...).
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ParseConfigID{}
+
+// configIDAttributeTypes are the attributes of the object returned by parse_config_id.
+func configIDAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"branch_id":        types.Int64Type,
+		"component_id":     types.StringType,
+		"configuration_id": types.StringType,
+	}
+}
+
+// ParseConfigID splits the compound ID of a keboola_component_configuration.
+type ParseConfigID struct{}
+
+// NewParseConfigID is a helper function to simplify the provider implementation.
+func NewParseConfigID() function.Function {
+	return &ParseConfigID{}
+}
+
+// Metadata returns the function name.
+func (f *ParseConfigID) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_config_id"
+}
+
+// Definition defines the parameters and the return type.
+func (f *ParseConfigID) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a configuration ID",
+		MarkdownDescription: "Splits the `id` of a `keboola_component_configuration`, " +
+			"in the `branchId/componentId/configId` format, into an object with the " +
+			"`branch_id`, `component_id` and `configuration_id` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "Configuration ID in the `branchId/componentId/configId` format.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: configIDAttributeTypes(),
+		},
+	}
+}
+
+// Run parses the ID.
+func (f *ParseConfigID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	key, err := configuration.ParseConfigModelID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	result, diags := types.ObjectValue(configIDAttributeTypes(), map[string]attr.Value{
+		"branch_id":        types.Int64Value(int64(key.BranchID)),
+		"component_id":     types.StringValue(key.ComponentID.String()),
+		"configuration_id": types.StringValue(key.ID.String()),
+	})
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// Option customizes the provider created by New, e.g. to run it against a fake API in tests.
+type Option func(p *keboolaProvider)
+
+// WithTransport sets the round tripper sending the API requests, instead of http.DefaultTransport.
+// Retries and rate limiting configured in the provider block still apply.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(p *keboolaProvider) {
+		p.transport = transport
+	}
+}
+
+// WithComponents sets the components available in the project, so they are not fetched when the provider is configured.
+func WithComponents(components []*keboola.Component) Option {
+	return func(p *keboolaProvider) {
+		p.components = components
+	}
+}
+
+// WithResources replaces the resources of the provider.
+func WithResources(resources ...func() resource.Resource) Option {
+	return func(p *keboolaProvider) {
+		p.resources = resources
+	}
+}
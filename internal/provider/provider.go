@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -13,11 +17,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/datasources/currenttoken"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/datasources/jobs"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/functions"
 	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/branch"
 	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/branch/metadata"
 	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
 	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/encryption"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/job"
 	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/scheduler"
+	storagemetadata "github.com/keboola/terraform-provider-keboola/internal/provider/resources/storage/metadata"
+	storagetoken "github.com/keboola/terraform-provider-keboola/internal/provider/resources/storage/token"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/transport"
 	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
 )
 
@@ -28,26 +41,43 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &keboolaProvider{version: "dev"}
+	_ provider.Provider                  = &keboolaProvider{version: "dev"}
+	_ provider.ProviderWithFunctions     = &keboolaProvider{version: "dev"}
+	_ provider.ProviderWithListResources = &keboolaProvider{version: "dev"}
 )
 
 // keboolaProvider is the provider implementation.
 type keboolaProvider struct {
 	version string
+	// transport, components and resources are overridden by options, see Option
+	transport  http.RoundTripper
+	components []*keboola.Component
+	resources  []func() resource.Resource
 }
 
 // keboolaProviderModel maps provider schema data to a Go type.
 type keboolaProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host                   types.String  `tfsdk:"host"`
+	Token                  types.String  `tfsdk:"token"`
+	PreflightResourceTypes types.List    `tfsdk:"preflight_resource_types"`
+	ReadOnly               types.Bool    `tfsdk:"read_only"`
+	RetryMaxAttempts       types.Int64   `tfsdk:"retry_max_attempts"`
+	RetryMaxWait           types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond      types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests  types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 // New creates a new provider instance.
-func New(version string) func() provider.Provider {
+func New(version string, opts ...Option) func() provider.Provider {
 	return func() provider.Provider {
-		return &keboolaProvider{
+		p := &keboolaProvider{
 			version: version,
 		}
+		for _, opt := range opts {
+			opt(p)
+		}
+
+		return p
 	}
 }
 
@@ -79,6 +109,37 @@ func (p *keboolaProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Sensitive:   true,
 				Description: tokenEnvVar,
 			},
+			"preflight_resource_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Resource types managed with the provider, e.g. keboola_component_configuration. " +
+					"If set, the provider checks that the token can manage them before any resource operation starts.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional: true,
+				Description: "If true, the provider refuses to create, update or delete any resource. " +
+					"Reads and data sources keep working.",
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of attempts of an API request failed due to a transient error. " +
+					"Defaults to 5, 1 disables retries.",
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum wait in seconds between two attempts of an API request. Defaults to 30.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional: true,
+				Description: "Maximum number of API requests per second, shared by all resources and data sources. " +
+					"Fractions limit the rate below one request per second, e.g. 0.5 is one request every two seconds. " +
+					"Unlimited if not set.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of API requests in flight at the same time, " +
+					"shared by all resources and data sources. Unlimited if not set.",
+			},
 		},
 	}
 }
@@ -172,6 +233,50 @@ func (p *keboolaProvider) Configure(
 		)
 	}
 
+	// Retry transient API failures
+	retryConfig := transport.DefaultRetryConfig()
+	if !config.RetryMaxAttempts.IsNull() {
+		if config.RetryMaxAttempts.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_attempts"),
+				"Invalid Retry Max Attempts",
+				"The maximum number of attempts must be at least 1.",
+			)
+		}
+		retryConfig.MaxAttempts = int(config.RetryMaxAttempts.ValueInt64())
+	}
+
+	if !config.RetryMaxWait.IsNull() {
+		if config.RetryMaxWait.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				"The maximum wait must not be negative.",
+			)
+		}
+		retryConfig.MaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	// Limit the rate and the concurrency of API requests
+	rateLimitConfig := transport.RateLimitConfig{
+		RequestsPerSecond: config.RequestsPerSecond.ValueFloat64(),
+		MaxConcurrent:     int(config.MaxConcurrentRequests.ValueInt64()),
+	}
+	if !config.RequestsPerSecond.IsNull() && rateLimitConfig.RequestsPerSecond <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Requests Per Second",
+			"The maximum number of requests per second must be greater than 0.",
+		)
+	}
+	if !config.MaxConcurrentRequests.IsNull() && rateLimitConfig.MaxConcurrent < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Max Concurrent Requests",
+			"The maximum number of concurrent requests must be at least 1.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -181,7 +286,13 @@ func (p *keboolaProvider) Configure(
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "keboola_token")
 
 	// Create a new Keboola Storage API client using the configuration values
-	sapiClient, err := keboola.NewAuthorizedAPI(ctx, host, token)
+	rateLimiter := transport.NewRateLimiter(rateLimitConfig)
+	httpClient := transport.NewClient(transport.Config{
+		Retry:       retryConfig,
+		RateLimiter: rateLimiter,
+		Transport:   p.transport,
+	})
+	sapiClient, err := keboola.NewAuthorizedAPI(ctx, host, token, keboola.WithClient(&httpClient))
 	if err != nil {
 		resp.Diagnostics.AddError("Could not initialize Keboola client", err.Error())
 
@@ -195,39 +306,82 @@ func (p *keboolaProvider) Configure(
 		return
 	}
 
-	// Retrieve all components from Keboola Connection
-	tflog.Info(ctx, "Fetching all components from Keboola Connection")
-	// The IndexComponentsRequest retrieves all components available in the Keboola Connection project.
-	// This is a one-time fetch during provider configuration to avoid repeated API calls.
-	stackComponents, err := sapiClient.IndexComponentsRequest().Send(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to retrieve components from Keboola Connection", err.Error())
+	// Optionally check that the token can manage the declared resource types before any resource operation
+	if !config.PreflightResourceTypes.IsNull() {
+		var resourceTypes []string
+		resp.Diagnostics.Append(config.PreflightResourceTypes.ElementsAs(ctx, &resourceTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-		return
+		tflog.Info(ctx, "Checking token permissions", map[string]any{"resource_types": resourceTypes})
+		resp.Diagnostics.Append(common.CheckPermissions(path.Root("preflight_resource_types"), tokenObject, resourceTypes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Retrieve all components from Keboola Connection, unless they are set by an option
+	components := p.components
+	if components == nil {
+		tflog.Info(ctx, "Fetching all components from Keboola Connection")
+		// The IndexComponentsRequest retrieves all components available in the Keboola Connection project.
+		// This is a one-time fetch during provider configuration to avoid repeated API calls.
+		stackComponents, err := sapiClient.IndexComponentsRequest().Send(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to retrieve components from Keboola Connection", err.Error())
+
+			return
+		}
+		components = stackComponents.Components
 	}
 
 	// Make the Keboola client and components available during DataSource and Resource
 	// type Configure methods.
+	api := apiclient.New(sapiClient)
 	data := &providermodels.ProviderData{
 		Client:     sapiClient,
 		Token:      tokenObject,
-		Components: stackComponents.Components, // Access the slice of components from the IndexComponents struct
+		Components: components,
+		Configs:    api,
+		Branches:   api,
+		Metadata:   api,
+		Encryption: api,
+		Schedules:  api,
+		Jobs:       api,
+		ReadOnly:   config.ReadOnly.ValueBool(),
+	}
+
+	if data.ReadOnly {
+		tflog.Info(ctx, "Provider is in read-only mode, mutating operations will be refused")
 	}
 
 	// Set the provider data
 	resp.DataSourceData = data
 	resp.ResourceData = data
+	resp.ListResourceData = data
 
 	tflog.Info(ctx, "Configured Keboola API client")
 }
 
 // DataSources defines the data sources implemented by the provider.
 func (p *keboolaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		func() datasource.DataSource {
+			return currenttoken.NewDataSource()
+		},
+		func() datasource.DataSource {
+			return jobs.NewDataSource()
+		},
+	}
 }
 
 // Resources defines the resources implemented by the provider.
 func (p *keboolaProvider) Resources(_ context.Context) []func() resource.Resource {
+	if p.resources != nil {
+		return p.resources
+	}
+
 	return []func() resource.Resource{
 		func() resource.Resource {
 			return configuration.NewResource()
@@ -244,5 +398,39 @@ func (p *keboolaProvider) Resources(_ context.Context) []func() resource.Resourc
 		func() resource.Resource {
 			return metadata.NewResource()
 		},
+		func() resource.Resource {
+			return storagemetadata.NewResource()
+		},
+		func() resource.Resource {
+			return storagetoken.NewResource()
+		},
+		func() resource.Resource {
+			return job.NewResource()
+		},
+	}
+}
+
+// ListResources defines the list resources implemented by the provider, they require Terraform 1.14 or later.
+func (p *keboolaProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		func() list.ListResource {
+			return configuration.NewListResource()
+		},
+		func() list.ListResource {
+			return branch.NewListResource()
+		},
+		func() list.ListResource {
+			return scheduler.NewListResource()
+		},
+	}
+}
+
+// Functions defines the provider-defined functions, they require Terraform 1.8 or later.
+func (p *keboolaProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseConfigID,
+		functions.NewConfigID,
+		functions.NewNormalizeJSON,
+		functions.NewIsEncrypted,
 	}
 }
@@ -0,0 +1,124 @@
+package branch
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &ListResource{branches: nil, mapper: nil}
+	_ list.ListResourceWithConfigure = &ListResource{branches: nil, mapper: nil}
+)
+
+// ListResource lists the branches of the project for terraform query.
+// The identity of each branch is the identity of the branch resource.
+type ListResource struct {
+	branches apiclient.Branches
+	mapper   *Mapper
+}
+
+// NewListResource is a helper function to simplify the provider implementation.
+func NewListResource() *ListResource {
+	return &ListResource{}
+}
+
+// Metadata returns the type name of the listed resource.
+func (l *ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch"
+}
+
+// ListResourceConfigSchema defines the schema of the list block, branches have no filters.
+func (l *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists all branches of the project.",
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (l *ListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+	l.branches = providerData.Branches
+
+	// The listed resources are mapped the same way as by the resource
+	l.mapper = &Mapper{
+		projectID: providerData.Token.ProjectID(),
+	}
+}
+
+// List streams the branches sorted by ID.
+func (l *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing branches")
+
+	branches, err := l.branches.ListBranches(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error listing branches", "could not list branches: "+err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	slices.SortFunc(branches, func(a, b *keboola.Branch) int { return cmp.Compare(a.ID, b.ID) })
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, branch := range branches {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = branch.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, IdentityModel{ID: types.Int64Value(int64(branch.ID))})...)
+			if req.IncludeResource {
+				l.setResource(ctx, branch, &result)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// setResource sets the resource of the result, the same state as the import of the branch followed by a read.
+func (l *ListResource) setResource(ctx context.Context, branch *keboola.Branch, result *list.ListResult) {
+	// The ID sets the other attributes to null values of their types
+	result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), int64(branch.ID))...)
+
+	var model Model
+	result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Diagnostics.Append(l.mapper.MapAPIToTerraform(ctx, branch, &model)...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+}
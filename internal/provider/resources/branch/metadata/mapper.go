@@ -2,15 +2,12 @@ package metadata
 
 import (
 	"context"
-	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 )
 
-var ErrNoMetadataID = errors.New("failed to find metadata id")
-
 // Mapper implements ResourceMapper for branch resources.
 type Mapper struct {
 	projectID int
@@ -3,20 +3,28 @@ package metadata
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 
 	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
 	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource = &Resource{
-		base: abstraction.BaseResource[Model, *keboola.MetadataDetail]{}, client: nil, projectID: 0,
+		base: abstraction.BaseResource[Model, *keboola.MetadataDetail]{}, metadata: nil, projectID: 0,
+	}
+	_ resource.ResourceWithImportState = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.MetadataDetail]{}, metadata: nil, projectID: 0,
 	}
 )
 
@@ -25,8 +33,8 @@ type Resource struct {
 	// Base functionality with branch model specifics
 	base abstraction.BaseResource[Model, *keboola.MetadataDetail]
 
-	// Direct access to the API client for specific operations
-	client    *keboola.AuthorizedAPI
+	// API client for specific operations
+	metadata  apiclient.Metadata
 	projectID int
 }
 
@@ -41,14 +49,16 @@ func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res
 }
 
 // Schema defines the schema for the resource.
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server
 		MarkdownDescription: "Branch resource",
 		Description:         "Development branch resource",
 		DeprecationMessage:  "",
 		Version:             1,
-		Blocks:              map[string]schema.Block{},
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -82,9 +92,12 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, _
 	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
 
 	// Set up the API client
-	r.client = providerData.Client
+	r.metadata = providerData.Metadata
 	r.projectID = providerData.Token.ProjectID()
 
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
 	// Set up the mapper
 	r.base.Mapper = &Mapper{
 		projectID: r.projectID,
@@ -108,22 +121,21 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	// Use the base resource abstraction for Read
 	r.base.ExecuteRead(ctx, req, resp, func(ctx context.Context, state Model) (*keboola.MetadataDetail, error) {
 		// Get metadata with matching ID
-		branch, err := r.client.ListBranchMetadataRequest(
-			keboola.BranchKey{
-				ID: keboola.BranchID(state.BranchID.ValueInt64()),
-			},
-		).Send(ctx)
+		branch, err := r.metadata.ListBranchMetadata(ctx, keboola.BranchKey{
+			ID: keboola.BranchID(state.BranchID.ValueInt64()),
+		})
 		if err != nil {
 			return nil, fmt.Errorf("could not get branch metadata: %w", err)
 		}
 
-		value := branch.ToMap()[state.Key.ValueString()]
+		// The entry is looked up by the key, e.g. the imported state has no ID yet.
+		// A missing entry is removed from the state, so a wrong import ID fails and the next apply creates it again.
+		detail := common.FindMetadata(branch, "", state.Key.ValueString())
+		if detail == nil {
+			return nil, fmt.Errorf("branch metadata %q: %w", state.Key.ValueString(), abstraction.ErrResourceNotFound)
+		}
 
-		return &keboola.MetadataDetail{
-			ID:    state.ID.ValueString(),
-			Key:   state.Key.ValueString(),
-			Value: value,
-		}, nil
+		return detail, nil
 	})
 }
 
@@ -149,7 +161,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		}
 
 		// Delete the branch
-		err := r.client.DeleteBranchMetadataRequest(key, state.ID.ValueString()).SendOrErr(ctx)
+		err := r.metadata.DeleteBranchMetadata(ctx, key, state.ID.ValueString())
 		if err != nil {
 			return fmt.Errorf("could not delete branch metadata: %w", err)
 		}
@@ -158,42 +170,66 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	})
 }
 
-func (r *Resource) updateMetadata(ctx context.Context, model Model) (*keboola.MetadataDetail, error) {
-	metadata := make(keboola.Metadata)
-	metadata[model.Key.ValueString()] = model.Value.ValueString()
+// ImportState imports an existing metadata entry by the ID in the branchId/key format.
+// The key may contain slashes, only the first one separates the branch ID.
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	branchID, key, found := strings.Cut(req.ID, "/")
+	id, err := strconv.ParseInt(branchID, 10, 64)
+	if !found || err != nil || id <= 0 || key == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("The ID must be in the branchId/key format, got %q.", req.ID),
+		)
 
-	// Call the API to create the branch
-	branchKey := keboola.BranchKey{
-		ID: keboola.BranchID(int(model.BranchID.ValueInt64())),
+		return
 	}
 
-	_, err := r.client.AppendBranchMetadataRequest(
-		branchKey,
-		metadata,
-	).Send(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create metadata: %w", err)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+func (r *Resource) updateMetadata(ctx context.Context, model Model) (*keboola.MetadataDetail, error) {
+	target := &branchTarget{
+		metadata: r.metadata,
+		key: keboola.BranchKey{
+			ID: keboola.BranchID(int(model.BranchID.ValueInt64())),
+		},
 	}
 
-	result, err := r.client.ListBranchMetadataRequest(branchKey).Send(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list metadata: %w", err)
+	return common.AppendMetadata(ctx, target, "", model.Key.ValueString(), model.Value.ValueString())
+}
+
+// branchTarget implements common.MetadataTarget for branch metadata.
+// Branch metadata has no provider namespace, so the provider argument is ignored.
+type branchTarget struct {
+	metadata apiclient.Metadata
+	key      keboola.BranchKey
+}
+
+// Append appends metadata to the branch.
+func (t *branchTarget) Append(ctx context.Context, _ string, metadata keboola.Metadata) error {
+	if err := t.metadata.AppendBranchMetadata(ctx, t.key, metadata); err != nil {
+		return fmt.Errorf("could not append branch metadata: %w", err)
 	}
 
-	var id string
-	for _, metadataDetail := range *result {
-		if metadataDetail.Key == model.Key.ValueString() {
-			id = metadataDetail.ID
-		}
+	return nil
+}
+
+// List lists all metadata of the branch.
+func (t *branchTarget) List(ctx context.Context) (keboola.MetadataDetails, error) {
+	result, err := t.metadata.ListBranchMetadata(ctx, t.key)
+	if err != nil {
+		return nil, fmt.Errorf("could not list branch metadata: %w", err)
 	}
 
-	if id == "" {
-		return nil, ErrNoMetadataID
+	return result, nil
+}
+
+// Delete deletes a single metadata entry of the branch.
+func (t *branchTarget) Delete(ctx context.Context, metadataID string) error {
+	if err := t.metadata.DeleteBranchMetadata(ctx, t.key, metadataID); err != nil {
+		return fmt.Errorf("could not delete branch metadata: %w", err)
 	}
 
-	return &keboola.MetadataDetail{
-		ID:    id,
-		Key:   model.Key.ValueString(),
-		Value: model.Value.ValueString(),
-	}, nil
+	return nil
 }
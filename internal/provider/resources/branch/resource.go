@@ -2,22 +2,35 @@ package branch
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 
 	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
 	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource = &Resource{
-		base: abstraction.BaseResource[Model, *keboola.Branch]{}, client: nil, projectID: 0,
+		base: abstraction.BaseResource[Model, *keboola.Branch]{}, branches: nil, projectID: 0,
+	}
+	_ resource.ResourceWithImportState = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.Branch]{}, branches: nil, projectID: 0,
+	}
+	_ resource.ResourceWithIdentity = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.Branch]{}, branches: nil, projectID: 0,
 	}
 )
 
@@ -26,8 +39,8 @@ type Resource struct {
 	// Base functionality with branch model specifics
 	base abstraction.BaseResource[Model, *keboola.Branch]
 
-	// Direct access to the API client for specific operations
-	client    *keboola.AuthorizedAPI
+	// API client for specific operations
+	branches  apiclient.Branches
 	projectID int
 }
 
@@ -42,16 +55,19 @@ func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res
 }
 
 // Schema defines the schema for the resource.
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server
 		MarkdownDescription: "Branch resource",
 		Description:         "Development branch resource",
 		DeprecationMessage:  "",
 		Version:             1,
-		Blocks:              map[string]schema.Block{},
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
 
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": abstraction.DeletionProtectionAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Branch ID",
 				Computed:            true,
@@ -85,13 +101,29 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, _
 	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
 
 	// Set up the API client
-	r.client = providerData.Client
+	r.branches = providerData.Branches
 	r.projectID = providerData.Token.ProjectID()
 
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
+	// Default operation timeouts, overridable by the timeouts block
+	r.base.Timeouts = abstraction.Timeouts{
+		Create: 10 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 5 * time.Minute,
+		Delete: 20 * time.Minute,
+	}
+
 	// Set up the mapper
 	r.base.Mapper = &Mapper{
 		projectID: r.projectID,
 	}
+
+	// The identity is the branch ID
+	r.base.Identity = func(model Model) any {
+		return IdentityModel{ID: model.ID}
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -106,12 +138,28 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 			return nil, fmt.Errorf("failed to map Terraform model to API: %w", err)
 		}
 
-		// Call the API to create the branch
-		result, err := r.client.CreateBranchRequest(apiModel).Send(ctx)
+		// Branch creation runs as an asynchronous storage job
+		job, err := r.branches.CreateBranchAsync(ctx, apiModel)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create branch: %w", err)
 		}
 
+		job, err = abstraction.WaitForStorageJob(ctx, r.branches, job)
+		if err != nil {
+			return nil, err
+		}
+
+		// The job results contain the created branch
+		var created keboola.Branch
+		if err := decodeJobResults(job, &created); err != nil {
+			return nil, err
+		}
+
+		result, err := r.branches.GetBranch(ctx, created.BranchKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not get created branch: %w", err)
+		}
+
 		return result, nil
 	})
 }
@@ -123,11 +171,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	// Use the base resource abstraction for Read
 	r.base.ExecuteRead(ctx, req, resp, func(ctx context.Context, state Model) (*keboola.Branch, error) {
 		// Get branch with matching ID
-		branch, err := r.client.GetBranchRequest(
-			keboola.BranchKey{
-				ID: keboola.BranchID(state.ID.ValueInt64()),
-			},
-		).Send(ctx)
+		branch, err := r.branches.GetBranch(ctx, keboola.BranchKey{
+			ID: keboola.BranchID(state.ID.ValueInt64()),
+		})
 		if err != nil {
 			return nil, fmt.Errorf("could not get branch: %w", err)
 		}
@@ -157,7 +203,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		}
 
 		// Call the API to create the branch
-		result, err := r.client.UpdateBranchRequest(apiModel, changedFields).Send(ctx)
+		result, err := r.branches.UpdateBranch(ctx, apiModel, changedFields)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create branch: %w", err)
 		}
@@ -177,12 +223,75 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 			ID: keboola.BranchID(state.ID.ValueInt64()),
 		}
 
-		// Delete the branch
-		err := r.client.DeleteBranchRequest(key).SendOrErr(ctx)
+		// Branch deletion runs as an asynchronous storage job
+		job, err := r.branches.DeleteBranchAsync(ctx, key)
 		if err != nil {
 			return fmt.Errorf("could not delete branch: %w", err)
 		}
 
+		if _, err := abstraction.WaitForStorageJob(ctx, r.branches, job); err != nil {
+			return err
+		}
+
 		return nil
 	})
 }
+
+// decodeJobResults decodes the results of a finished storage job into the target.
+func decodeJobResults(job *keboola.StorageJob, target any) error {
+	data, err := json.Marshal(job.Results)
+	if err != nil {
+		return fmt.Errorf("could not encode results of storage job %d: %w", job.ID, err)
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("could not decode results of storage job %d: %w", job.ID, err)
+	}
+
+	return nil
+}
+
+// IdentitySchema defines the identity of the resource, the branch ID.
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Id of the branch.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ImportState imports an existing branch by its numeric ID or by the identity,
+// the rest of the state is filled by the following Read.
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity IdentityModel
+	if req.ID != "" {
+		id, err := strconv.ParseInt(req.ID, 10, 64)
+		if err != nil || id <= 0 {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("The branch ID must be a positive number, got %q.", req.ID),
+			)
+
+			return
+		}
+
+		identity.ID = types.Int64Value(id)
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+}
@@ -0,0 +1,153 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+)
+
+// getIgnorePaths returns the JSON pointers of the ignore_paths attribute.
+func getIgnorePaths(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	var paths []string
+	if list.IsNull() || list.IsUnknown() {
+		return paths, nil
+	}
+
+	diags := list.ElementsAs(ctx, &paths, false)
+
+	return paths, diags
+}
+
+// validateIgnorePaths reports invalid JSON pointers of the ignore_paths attribute.
+func validateIgnorePaths(ctx context.Context, attrPath path.Path, list types.List) diag.Diagnostics {
+	paths, diags := getIgnorePaths(ctx, list)
+	for _, pointer := range paths {
+		if _, err := common.ParseJSONPointer(pointer); err != nil {
+			diags.AddAttributeError(attrPath, "Invalid Ignore Path", err.Error())
+		}
+	}
+
+	return diags
+}
+
+// keepIgnoredPaths returns a copy of the remote content with the values at the ignored paths taken from the prior
+// content, so changes made outside of Terraform at these paths do not produce a diff.
+func keepIgnoredPaths(
+	ctx context.Context,
+	remote *orderedmap.OrderedMap,
+	prior types.String,
+	ignorePaths types.List,
+	diags *diag.Diagnostics,
+) *orderedmap.OrderedMap {
+	paths, pathDiags := getIgnorePaths(ctx, ignorePaths)
+	diags.Append(pathDiags...)
+	if remote == nil || len(paths) == 0 || prior.IsNull() || prior.IsUnknown() {
+		return remote
+	}
+
+	priorContent, err := common.ParseJSON(prior)
+	if err != nil {
+		diags.AddWarning("Error processing ignore_paths", "Could not parse prior configuration content: "+err.Error())
+
+		return remote
+	}
+
+	content := remote.Clone()
+	if err := common.CopyJSONPointers(priorContent, content, paths); err != nil {
+		diags.AddWarning("Error processing ignore_paths", err.Error())
+
+		return remote
+	}
+
+	return content
+}
+
+// MergeIgnoredPaths copies the remote values at the ignored paths into the payload of an update,
+// so the update does not revert changes made by Keboola or in the UI.
+func (m *ConfigMapper) MergeIgnoredPaths(
+	ctx context.Context,
+	plan ConfigModel,
+	payload *keboola.ConfigWithRows,
+	remote *keboola.ConfigWithRows,
+) error {
+	paths, diags := getIgnorePaths(ctx, plan.IgnorePaths)
+	if diags.HasError() {
+		return fmt.Errorf("%w: %v", ErrParseIgnorePaths, diags)
+	}
+
+	if remote.Config != nil && remote.Content != nil {
+		if err := common.CopyJSONPointers(remote.Content, payload.Content, paths); err != nil {
+			return err
+		}
+	}
+
+	// Rows are matched by ID, new rows have no remote values
+	rowModels, diags := m.RowHandler.ExtractChildModels(ctx, plan)
+	if diags.HasError() {
+		return fmt.Errorf("%w: %v", ErrExtractPlanRowModels, diags)
+	}
+
+	rowPaths := make(map[keboola.RowID][]string, len(rowModels))
+	for _, rowModel := range rowModels {
+		if rowModel.ID.IsNull() || rowModel.ID.IsUnknown() {
+			continue
+		}
+
+		paths, diags := getIgnorePaths(ctx, rowModel.IgnorePaths)
+		if diags.HasError() {
+			return fmt.Errorf("%w: %v", ErrParseIgnorePaths, diags)
+		}
+
+		rowPaths[keboola.RowID(rowModel.ID.ValueString())] = paths
+	}
+
+	remoteRows := make(map[keboola.RowID]*keboola.ConfigRow, len(remote.Rows))
+	for _, row := range remote.Rows {
+		remoteRows[row.ID] = row
+	}
+
+	for _, row := range payload.Rows {
+		paths := rowPaths[row.ID]
+		if len(paths) == 0 {
+			continue
+		}
+
+		remoteRow, ok := remoteRows[row.ID]
+		if !ok || remoteRow.Content == nil {
+			continue
+		}
+
+		if err := common.CopyJSONPointers(remoteRow.Content, row.Content, paths); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HasIgnorePaths returns true if the configuration or any of its rows ignores some paths.
+func HasIgnorePaths(ctx context.Context, model ConfigModel) bool {
+	if paths, _ := getIgnorePaths(ctx, model.IgnorePaths); len(paths) > 0 {
+		return true
+	}
+
+	var rows []RowModel
+	if !model.Rows.IsNull() && !model.Rows.IsUnknown() {
+		model.Rows.ElementsAs(ctx, &rows, false)
+	}
+
+	for _, row := range rows {
+		if paths, _ := getIgnorePaths(ctx, row.IgnorePaths); len(paths) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
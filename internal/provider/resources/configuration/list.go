@@ -0,0 +1,194 @@
+package configuration
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &ListResource{configs: nil, branches: nil, mapper: nil}
+	_ list.ListResourceWithConfigure = &ListResource{configs: nil, branches: nil, mapper: nil}
+)
+
+// ListResource lists the configurations of a branch for terraform query.
+// The identity of each configuration is the identity of the configuration resource.
+type ListResource struct {
+	configs  apiclient.Configs
+	branches apiclient.Branches
+	mapper   *ConfigMapper
+}
+
+// ListModel defines the configuration of the list block.
+type ListModel struct {
+	BranchID    types.Int64  `tfsdk:"branch_id"`
+	ComponentID types.String `tfsdk:"component_id"`
+}
+
+// NewListResource is a helper function to simplify the provider implementation.
+func NewListResource() *ListResource {
+	return &ListResource{}
+}
+
+// Metadata returns the type name of the listed resource.
+func (l *ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component_configuration"
+}
+
+// ListResourceConfigSchema defines the schema of the list block.
+func (l *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the component configurations of a branch.",
+		Attributes: map[string]listschema.Attribute{
+			"branch_id": listschema.Int64Attribute{
+				Description: "Id of the branch. If not specified, then default branch will be used.",
+				Optional:    true,
+			},
+			"component_id": listschema.StringAttribute{
+				Description: "Id of the component. If specified, only its configurations are listed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured clients to the list resource.
+func (l *ListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+	l.configs = providerData.Configs
+	l.branches = providerData.Branches
+
+	// The listed resources are mapped the same way as by the resource
+	isTest := os.Getenv("TF_ACC") != "" //nolint: forbidigo
+	l.mapper = &ConfigMapper{
+		RowHandler: &DefaultConfigRowHandler{
+			Configs: l.configs,
+			isTest:  isTest,
+		},
+		isTest:              isTest,
+		AvailableComponents: providerData.Components,
+	}
+}
+
+// List streams the configurations sorted by the component and the configuration ID.
+func (l *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing component configurations")
+
+	var model ListModel
+	diags := req.Config.Get(ctx, &model)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	configs, err := l.listConfigs(ctx, model)
+	if err != nil {
+		diags.AddError("Error listing component configurations", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, config := range configs {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = config.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, NewIdentityModel(config.ConfigKey))...)
+			if req.IncludeResource {
+				l.setResource(ctx, config, &result)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// listConfigs returns the configurations of the branch, the default branch is used if not specified.
+func (l *ListResource) listConfigs(ctx context.Context, model ListModel) ([]*keboola.ConfigWithRows, error) {
+	if model.BranchID.IsNull() {
+		branch, err := l.branches.GetDefaultBranch(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get default branch: %w", err)
+		}
+		model.BranchID = types.Int64Value(int64(branch.ID))
+	}
+
+	branchKey := keboola.BranchKey{ID: keboola.BranchID(model.BranchID.ValueInt64())}
+	components, err := l.configs.ListConfigsAndRows(ctx, branchKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not list configurations of the branch %s: %w", branchKey.ID, err)
+	}
+
+	var configs []*keboola.ConfigWithRows
+	for _, component := range components {
+		if !model.ComponentID.IsNull() && component.ID.String() != model.ComponentID.ValueString() {
+			continue
+		}
+
+		for _, config := range component.Configs {
+			// The key of the listed configuration is set from the request
+			config.ConfigKey = keboola.ConfigKey{BranchID: branchKey.ID, ComponentID: component.ID, ID: config.ID}
+			configs = append(configs, config)
+		}
+	}
+
+	slices.SortFunc(configs, func(a, b *keboola.ConfigWithRows) int {
+		return cmp.Or(
+			strings.Compare(a.ComponentID.String(), b.ComponentID.String()),
+			strings.Compare(a.ID.String(), b.ID.String()),
+		)
+	})
+
+	return configs, nil
+}
+
+// setResource sets the resource of the result, the same state as the import of the configuration followed by a read.
+func (l *ListResource) setResource(ctx context.Context, config *keboola.ConfigWithRows, result *list.ListResult) {
+	// The ID sets the other attributes to null values of their types
+	result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), FormatConfigModelID(config.ConfigKey))...)
+
+	var model ConfigModel
+	result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Diagnostics.Append(l.mapper.MapAPIToTerraform(ctx, config, &model)...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+}
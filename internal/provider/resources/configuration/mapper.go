@@ -20,6 +20,7 @@ var (
 	ErrExtractStateRowModels = errors.New("failed to extract state row models")
 	ErrParseConfigContent    = errors.New("could not parse configuration content")
 	ErrMapRowModelsToAPI     = errors.New("failed to map row models to API")
+	ErrParseIgnorePaths      = errors.New("failed to parse ignore paths")
 )
 
 // ConfigMapper implements ResourceMapper for configuration resources.
@@ -51,13 +52,16 @@ func (m *ConfigMapper) MapAPIToTerraform(
 	tfModel.IsDeleted = types.BoolValue(apiModel.IsDeleted)
 	tfModel.IsDisabled = types.BoolValue(apiModel.IsDisabled)
 	tfModel.Created = types.StringValue(apiModel.Config.Created.UTC().String())
+	tfModel.Version = types.Int64Value(int64(apiModel.Version))
+	tfModel.State = formatOrderedMapField(apiModel.State, m.isTest)
 
 	// Set the compound ID
 	tfModel.ID = types.StringValue(GetConfigModelID(tfModel))
 
-	// Map configuration content
+	// Map configuration content, the ignored paths keep the values of the prior model
+	content := keepIgnoredPaths(ctx, apiModel.Content, tfModel.Content, tfModel.IgnorePaths, &diags)
 	tfModel.Content = types.StringValue("{}")
-	processConfigContent(apiModel.Content, &tfModel.Content, m.isTest, &diags)
+	processConfigContent(content, &tfModel.Content, m.isTest, &diags)
 
 	// Process rows if they exist
 	if len(apiModel.Rows) > 0 {
@@ -130,30 +134,6 @@ func (m *ConfigMapper) ValidateTerraformModel(
 ) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// If we're updating (oldModel != nil), validate immutable fields
-	if oldModel != nil {
-		if !newModel.ComponentID.IsUnknown() && oldModel.ComponentID != newModel.ComponentID {
-			diags.AddError(
-				"Error updating configuration",
-				"Cannot change component_id after configuration is created",
-			)
-		}
-
-		if !newModel.BranchID.IsUnknown() && oldModel.BranchID != newModel.BranchID {
-			diags.AddError(
-				"Error updating configuration",
-				"Cannot change branch_id after configuration is created",
-			)
-		}
-
-		if !newModel.ConfigID.IsUnknown() && oldModel.ConfigID != newModel.ConfigID {
-			diags.AddError(
-				"Error updating configuration",
-				"Cannot change configuration_id after configuration is created",
-			)
-		}
-	}
-
 	// Validate that the provided component_id exists in the list of available project components.
 	if !newModel.ComponentID.IsUnknown() && !newModel.ComponentID.IsNull() {
 		componentIDValue := newModel.ComponentID.ValueString()
@@ -179,6 +159,16 @@ func (m *ConfigMapper) ValidateTerraformModel(
 		}
 	}
 
+	// Validate JSON pointers of the ignored paths
+	diags.Append(validateIgnorePaths(ctx, path.Root("ignore_paths"), newModel.IgnorePaths)...)
+	if !newModel.Rows.IsNull() && !newModel.Rows.IsUnknown() {
+		var rowModels []RowModel
+		diags.Append(newModel.Rows.ElementsAs(ctx, &rowModels, false)...)
+		for i, row := range rowModels {
+			diags.Append(validateIgnorePaths(ctx, path.Root("rows").AtListIndex(i).AtName("ignore_paths"), row.IgnorePaths)...)
+		}
+	}
+
 	// Validate content JSON
 	if !newModel.Content.IsNull() && !newModel.Content.IsUnknown() {
 		contentMap := orderedmap.New()
@@ -1,25 +1,42 @@
 package configuration
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 )
 
+// ErrInvalidConfigModelID is returned when a compound configuration ID cannot be parsed.
+var ErrInvalidConfigModelID = errors.New("invalid configuration ID, expected branchId/componentId/configId")
+
 // Config represents the Terraform schema for a configuration.
 type ConfigModel struct {
-	ID                types.String `tfsdk:"id"`
-	BranchID          types.Int64  `tfsdk:"branch_id"`
-	ComponentID       types.String `tfsdk:"component_id"`
-	ConfigID          types.String `tfsdk:"configuration_id"`
-	Name              types.String `tfsdk:"name"`
-	Description       types.String `tfsdk:"description"`
-	ChangeDescription types.String `tfsdk:"change_description"`
-	IsDeleted         types.Bool   `tfsdk:"is_deleted"`
-	Created           types.String `tfsdk:"created"`
-	IsDisabled        types.Bool   `tfsdk:"is_disabled"`
-	Content           types.String `tfsdk:"configuration"`
-	Rows              types.List   `tfsdk:"rows"`
+	ID                 types.String   `tfsdk:"id"`
+	BranchID           types.Int64    `tfsdk:"branch_id"`
+	ComponentID        types.String   `tfsdk:"component_id"`
+	ConfigID           types.String   `tfsdk:"configuration_id"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	ChangeDescription  types.String   `tfsdk:"change_description"`
+	IsDeleted          types.Bool     `tfsdk:"is_deleted"`
+	Created            types.String   `tfsdk:"created"`
+	IsDisabled         types.Bool     `tfsdk:"is_disabled"`
+	Content            types.String   `tfsdk:"configuration"`
+	Rows               types.List     `tfsdk:"rows"`
+	IgnorePaths        types.List     `tfsdk:"ignore_paths"`
+	State              types.String   `tfsdk:"state"`
+	ResetStateOnChange types.String   `tfsdk:"reset_state_on_change"`
+	Version            types.Int64    `tfsdk:"version"`
+	ForceOverwrite     types.Bool     `tfsdk:"force_overwrite"`
+	PurgeOnDestroy     types.Bool     `tfsdk:"purge_on_destroy"`
+	RestoreIfDeleted   types.Bool     `tfsdk:"restore_if_deleted"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // RowModel represents the schema for a configuration row.
@@ -31,6 +48,23 @@ type RowModel struct {
 	IsDisabled        types.Bool   `tfsdk:"is_disabled"`
 	State             types.String `tfsdk:"state"`
 	Content           types.String `tfsdk:"configuration_row"`
+	IgnorePaths       types.List   `tfsdk:"ignore_paths"`
+}
+
+// IdentityModel is the resource identity of a configuration, the parts of its compound ID.
+type IdentityModel struct {
+	BranchID    types.Int64  `tfsdk:"branch_id"`
+	ComponentID types.String `tfsdk:"component_id"`
+	ConfigID    types.String `tfsdk:"configuration_id"`
+}
+
+// NewIdentityModel returns the resource identity of the configuration with the key.
+func NewIdentityModel(key keboola.ConfigKey) IdentityModel {
+	return IdentityModel{
+		BranchID:    types.Int64Value(int64(key.BranchID)),
+		ComponentID: types.StringValue(key.ComponentID.String()),
+		ConfigID:    types.StringValue(key.ID.String()),
+	}
 }
 
 // GetConfigModelID returns the compound ID for a configuration.
@@ -41,3 +75,36 @@ func GetConfigModelID(model *ConfigModel) string {
 		model.ConfigID.ValueString(),
 	)
 }
+
+// FormatConfigModelID returns the compound ID of the configuration with the key, the same as GetConfigModelID.
+func FormatConfigModelID(key keboola.ConfigKey) string {
+	return fmt.Sprintf("%d/%v/%v", key.BranchID, key.ComponentID, key.ID)
+}
+
+// ParseConfigModelID parses the compound ID created by GetConfigModelID.
+func ParseConfigModelID(id string) (keboola.ConfigKey, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return keboola.ConfigKey{}, fmt.Errorf("%w: %q", ErrInvalidConfigModelID, id)
+	}
+
+	branchID, err := strconv.Atoi(parts[0])
+	if err != nil || branchID <= 0 {
+		return keboola.ConfigKey{}, fmt.Errorf("%w: %q, the branch ID must be a positive number", ErrInvalidConfigModelID, id)
+	}
+
+	return keboola.ConfigKey{
+		BranchID:    keboola.BranchID(branchID),
+		ComponentID: keboola.ComponentID(parts[1]),
+		ID:          keboola.ConfigID(parts[2]),
+	}, nil
+}
+
+// GetConfigKey returns the API key of the configuration.
+func GetConfigKey(model *ConfigModel) keboola.ConfigKey {
+	return keboola.ConfigKey{
+		ID:          keboola.ConfigID(model.ConfigID.ValueString()),
+		BranchID:    keboola.BranchID(model.BranchID.ValueInt64()),
+		ComponentID: keboola.ComponentID(model.ComponentID.ValueString()),
+	}
+}
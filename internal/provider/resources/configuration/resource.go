@@ -2,34 +2,62 @@ package configuration
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/go-utils/pkg/orderedmap"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 
 	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
 	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource = &Resource{
-		base:   abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
-		client: nil,
-		isTest: false,
+		base:     abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
+		configs:  nil,
+		branches: nil,
+		isTest:   false,
 	}
 	_ resource.ResourceWithConfigure = &Resource{
-		base:   abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
-		client: nil,
-		isTest: false,
+		base:     abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
+		configs:  nil,
+		branches: nil,
+		isTest:   false,
+	}
+	_ resource.ResourceWithModifyPlan = &Resource{
+		base:     abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
+		configs:  nil,
+		branches: nil,
+		isTest:   false,
+	}
+	_ resource.ResourceWithImportState = &Resource{
+		base:     abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
+		configs:  nil,
+		branches: nil,
+		isTest:   false,
+	}
+	_ resource.ResourceWithIdentity = &Resource{
+		base:     abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
+		configs:  nil,
+		branches: nil,
+		isTest:   false,
 	}
 )
 
@@ -38,9 +66,10 @@ type Resource struct {
 	// Base functionality with config model specifics
 	base abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]
 
-	// Direct access to the API client for specific operations
-	client *keboola.AuthorizedAPI
-	isTest bool
+	// API clients for specific operations
+	configs  apiclient.Configs
+	branches apiclient.Branches
+	isTest   bool
 
 	// List of components available in the project, fetched during provider configuration.
 	// This is used to validate the component_id provided in the resource configuration.
@@ -50,9 +79,10 @@ type Resource struct {
 // NewResource is a helper function to simplify the provider implementation.
 func NewResource() *Resource {
 	return &Resource{
-		base:   abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
-		client: nil,
-		isTest: false,
+		base:     abstraction.BaseResource[ConfigModel, *keboola.ConfigWithRows]{},
+		configs:  nil,
+		branches: nil,
+		isTest:   false,
 	}
 }
 
@@ -62,14 +92,17 @@ func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res
 }
 
 // Schema defines the schema for the resource.
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Manages component configurations (https://keboola.docs.apiary.io/#reference/components-and-configurations).", //nolint: lll
 		MarkdownDescription: "Manages component configurations (https://keboola.docs.apiary.io/#reference/components-and-configurations).", //nolint: lll
-		Blocks:              map[string]schema.Block{},
-		DeprecationMessage:  "",
-		Version:             1,
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
+		DeprecationMessage: "",
+		Version:            1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": abstraction.DeletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Description:         "Unique string identifier assembled as branchId/componentId/configId.",
 				MarkdownDescription: "Unique string identifier assembled as branchId/componentId/configId.",
@@ -85,12 +118,16 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"component_id": schema.StringAttribute{
 				Description:         "Id of the component.",
 				MarkdownDescription: "Id of the component.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"branch_id": schema.Int64Attribute{
 				Description:         "Id of the branch. If not specified, then default branch will be used.",
@@ -99,6 +136,7 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -142,6 +180,49 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"ignore_paths": schema.ListAttribute{
+				Description: "JSON pointers (RFC 6901) of configuration content managed outside of Terraform, " +
+					"e.g. /authorization. Changes at these paths are not reported as a diff and updates keep the remote values.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the configuration specified as JSON string, e.g. the last position of an incremental load.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reset_state_on_change": schema.StringAttribute{
+				Description: "Arbitrary value, e.g. a hash of the extractor query. When it changes, " +
+					"the state of the configuration and of all its rows is cleared in the same apply.",
+				Optional: true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version of the configuration, incremented by each change. " +
+					"An update fails if the remote version differs, i.e. the configuration has been changed outside of Terraform.",
+				Computed: true,
+			},
+			"force_overwrite": schema.BoolAttribute{
+				Description: "If true, an update overwrites the configuration even if it has been changed outside of Terraform.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"purge_on_destroy": schema.BoolAttribute{
+				Description: "If true, the configuration is also permanently deleted from trash on destroy, " +
+					"so it can be created again with the same configuration_id. Must be applied before the destroy.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"restore_if_deleted": schema.BoolAttribute{
+				Description: "If true and a configuration with the same configuration_id is in trash, " +
+					"it is restored and updated instead of failing to create it.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"rows": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -186,6 +267,13 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 							Optional:    true,
 							Computed:    true,
 						},
+						"ignore_paths": schema.ListAttribute{
+							Description: "JSON pointers (RFC 6901) of row content managed outside of Terraform, " +
+								"e.g. /parameters/state. Changes at these paths are not reported as a diff " +
+								"and updates keep the remote values.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
 					},
 				},
 				Description: "Rows for the configuration",
@@ -208,20 +296,37 @@ func (r *Resource) Configure(
 
 	// Get the provider data - ignoring the type assertion success
 	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
-	r.client = providerData.Client
+	r.configs = providerData.Configs
+	r.branches = providerData.Branches
 	// Store the fetched components from provider data for validation purposes.
 	r.availableComponents = providerData.Components
 	r.isTest = os.Getenv("TF_ACC") != "" //nolint: forbidigo
 
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
+	// Default operation timeouts, overridable by the timeouts block
+	r.base.Timeouts = abstraction.Timeouts{
+		Create: 10 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 10 * time.Minute,
+		Delete: 5 * time.Minute,
+	}
+
 	// Set up the mapper
 	r.base.Mapper = &ConfigMapper{
 		RowHandler: &DefaultConfigRowHandler{
-			Client: r.client,
-			isTest: r.isTest,
+			Configs: r.configs,
+			isTest:  r.isTest,
 		},
 		isTest:              r.isTest,
 		AvailableComponents: r.availableComponents,
 	}
+
+	// The identity consists of the parts of the compound ID
+	r.base.Identity = func(model ConfigModel) any {
+		return NewIdentityModel(GetConfigKey(&model))
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -232,7 +337,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	r.base.ExecuteCreate(ctx, req, resp, func(ctx context.Context, plan ConfigModel) (*keboola.ConfigWithRows, error) {
 		// Handle default branch if not specified
 		if plan.BranchID.IsUnknown() {
-			branch, err := r.client.GetDefaultBranchRequest().Send(ctx)
+			branch, err := r.branches.GetDefaultBranch(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("could not get default branch: %w", err)
 			}
@@ -246,8 +351,13 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		}
 
 		// Create configuration via API
-		resConfig, err := r.client.CreateConfigRequest(apiModel, false).Send(ctx)
+		resConfig, err := r.configs.CreateConfig(ctx, apiModel, false)
 		if err != nil {
+			// The configuration with the explicit ID may be in trash
+			if plan.RestoreIfDeleted.ValueBool() && !plan.ConfigID.IsNull() && !plan.ConfigID.IsUnknown() {
+				return r.restoreConfig(ctx, plan, apiModel, err)
+			}
+
 			return nil, fmt.Errorf("could not create configuration: %w", err)
 		}
 
@@ -255,46 +365,107 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	})
 }
 
+// restoreConfig restores the configuration from trash and updates it and its rows to the planned values.
+// If the configuration is not in trash, the original create error is returned.
+func (r *Resource) restoreConfig(
+	ctx context.Context,
+	plan ConfigModel,
+	apiModel *keboola.ConfigWithRows,
+	createErr error,
+) (*keboola.ConfigWithRows, error) {
+	inTrash, err := r.isInTrash(ctx, apiModel.ConfigKey, createErr)
+	if err != nil {
+		return nil, fmt.Errorf("could not create configuration: %w (reading configuration failed: %w)", createErr, err)
+	}
+
+	if !inTrash {
+		return nil, fmt.Errorf("could not create configuration: %w", createErr)
+	}
+
+	tflog.Info(ctx, "Restoring configuration from trash", map[string]any{"configuration_id": apiModel.ID.String()})
+
+	if err := r.configs.RestoreConfig(ctx, apiModel.ConfigKey); err != nil {
+		return nil, fmt.Errorf("could not create configuration: %w (restore from trash failed: %w)", createErr, err)
+	}
+
+	// The restored configuration has its previous rows, they are reconciled with the planned rows
+	mapper, _ := r.base.Mapper.(*ConfigMapper)
+	rowsSortOrder, err := mapper.RowHandler.ReconcileRestoredRows(ctx, apiModel.ConfigKey, apiModel.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &keboola.ConfigWithRows{Config: apiModel.Config}
+	config.RowsSortOrder = rowsSortOrder
+	if _, err := r.configs.UpdateConfig(ctx, config, nil); err != nil {
+		return nil, fmt.Errorf("could not update restored configuration: %w", err)
+	}
+
+	return r.readConfigWithRows(ctx, plan)
+}
+
+// isInTrash returns true if the create error says the configuration ID is already used,
+// and there is no active configuration with the ID, so the configuration is in trash.
+func (r *Resource) isInTrash(ctx context.Context, key keboola.ConfigKey, createErr error) (bool, error) {
+	var apiErr interface{ StatusCode() int }
+	if !errors.As(createErr, &apiErr) {
+		return false, nil
+	}
+
+	// The Storage API responds with 400 configurationAlreadyExists, also for a configuration in trash
+	if status := apiErr.StatusCode(); status != http.StatusBadRequest && status != http.StatusConflict {
+		return false, nil
+	}
+
+	_, err := r.configs.GetConfig(ctx, key)
+	if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusNotFound {
+		return true, nil
+	}
+
+	return false, err
+}
+
 // Read resource information.
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Info(ctx, "Reading configuration resource")
 
 	// Use the base resource abstraction for Read
 	r.base.ExecuteRead(ctx, req, resp, func(ctx context.Context, state ConfigModel) (*keboola.ConfigWithRows, error) {
-		// Create key from model
-		key := keboola.ConfigKey{
-			ID:          keboola.ConfigID(state.ConfigID.ValueString()),
-			BranchID:    keboola.BranchID(state.BranchID.ValueInt64()),
-			ComponentID: keboola.ComponentID(state.ComponentID.ValueString()),
-		}
+		return r.readConfigWithRows(ctx, state)
+	})
+}
 
-		// Get configuration
-		config, err := r.client.GetConfigRequest(key).Send(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("could not read Configuration %s: %w", GetConfigModelID(&state), err)
-		}
+// readConfigWithRows fetches the configuration and its rows.
+func (r *Resource) readConfigWithRows(ctx context.Context, model ConfigModel) (*keboola.ConfigWithRows, error) {
+	// Create key from model
+	key := GetConfigKey(&model)
 
-		// Get rows separately
-		rowKey := keboola.ConfigRowKey{
-			ConfigID:    key.ID,
-			BranchID:    key.BranchID,
-			ComponentID: key.ComponentID,
-		}
+	// Get configuration
+	config, err := r.configs.GetConfig(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Configuration %s: %w", GetConfigModelID(&model), err)
+	}
 
-		// Fetch rows from API
-		rows, err := r.client.ListConfigRowRequest(rowKey).Send(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("could not read configuration rows: %w", err)
-		}
+	// Get rows separately
+	rowKey := keboola.ConfigRowKey{
+		ConfigID:    key.ID,
+		BranchID:    key.BranchID,
+		ComponentID: key.ComponentID,
+	}
 
-		// Prepare configuration with rows for mapping
-		configWithRows := &keboola.ConfigWithRows{
-			Config: config,
-			Rows:   *rows,
-		}
+	// Fetch rows from API
+	rows, err := r.configs.ListConfigRows(ctx, rowKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not read configuration rows: %w", err)
+	}
 
-		return configWithRows, nil
-	})
+	// Prepare configuration with rows for mapping
+	configWithRows := &keboola.ConfigWithRows{
+		Config: config,
+		Rows:   rows,
+	}
+
+	return configWithRows, nil
 }
 
 // Update updates the resource.
@@ -319,22 +490,213 @@ func (r *Resource) Update(
 			plan.ComponentID = state.ComponentID
 			plan.ConfigID = state.ConfigID
 
+			// Refuse to overwrite changes made outside of Terraform
+			if !plan.ForceOverwrite.ValueBool() {
+				if err := r.checkVersion(ctx, state); err != nil {
+					return nil, err
+				}
+			}
+
 			// Execute the update operation using the mapper
 			apiModel, err := r.base.Mapper.MapTerraformToAPI(ctx, state, plan)
 			if err != nil {
 				return nil, fmt.Errorf("failed to map Terraform model to API: %w", err)
 			}
 
+			// Keep the remote values at the ignored paths
+			if HasIgnorePaths(ctx, plan) {
+				if err := r.mergeIgnoredPaths(ctx, state, plan, apiModel); err != nil {
+					return nil, err
+				}
+			}
+
 			// Update configuration
-			resConfig, err := r.client.UpdateConfigRequest(apiModel, nil).Send(ctx)
+			resConfig, err := r.configs.UpdateConfig(ctx, apiModel, nil)
 			if err != nil {
 				return nil, fmt.Errorf("could not update configuration: %w", err)
 			}
 
+			// Clear the state of the configuration and its rows when the trigger changes
+			if !plan.ResetStateOnChange.Equal(state.ResetStateOnChange) {
+				if err := r.resetState(ctx, plan); err != nil {
+					return nil, err
+				}
+
+				return r.readConfigWithRows(ctx, plan)
+			}
+
 			return resConfig, nil
 		})
 }
 
+// checkVersion compares the version from the state with the current remote version of the configuration.
+func (r *Resource) checkVersion(ctx context.Context, state ConfigModel) error {
+	// The state of an older provider version does not contain the version
+	if state.Version.IsNull() || state.Version.IsUnknown() {
+		return nil
+	}
+
+	remote, err := r.configs.GetConfig(ctx, GetConfigKey(&state))
+	if err != nil {
+		return fmt.Errorf("could not read Configuration %s: %w", GetConfigModelID(&state), err)
+	}
+
+	if int64(remote.Version) != state.Version.ValueInt64() {
+		return fmt.Errorf(
+			"%w: configuration %s has version %d, but version %d is expected by Terraform; "+
+				"refresh the state and review the changes, or set force_overwrite = true",
+			abstraction.ErrConflict,
+			GetConfigModelID(&state),
+			remote.Version,
+			state.Version.ValueInt64(),
+		)
+	}
+
+	return nil
+}
+
+// resetState clears the state of the configuration and of all its rows.
+func (r *Resource) resetState(ctx context.Context, model ConfigModel) error {
+	tflog.Info(ctx, "Resetting configuration state", map[string]any{"id": GetConfigModelID(&model)})
+
+	key := GetConfigKey(&model)
+	config := &keboola.ConfigWithRows{
+		Config: &keboola.Config{ConfigKey: key, State: orderedmap.New()},
+	}
+	if _, err := r.configs.UpdateConfig(ctx, config, []string{"state"}); err != nil {
+		return fmt.Errorf("could not reset configuration state: %w", err)
+	}
+
+	rows, err := r.configs.ListConfigRows(ctx, keboola.ConfigRowKey{
+		ConfigID:    key.ID,
+		BranchID:    key.BranchID,
+		ComponentID: key.ComponentID,
+	})
+	if err != nil {
+		return fmt.Errorf("could not read configuration rows: %w", err)
+	}
+
+	for _, row := range rows {
+		update := &keboola.ConfigRow{ConfigRowKey: row.ConfigRowKey, State: orderedmap.New()}
+		if _, err := r.configs.UpdateConfigRow(ctx, update, []string{"state"}); err != nil {
+			return fmt.Errorf("could not reset state of configuration row %s: %w", row.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// ModifyPlan plans the cleared state when reset_state_on_change changes.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to reset on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan ConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ResetStateOnChange.Equal(state.ResetStateOnChange) {
+		return
+	}
+
+	// The new state is empty, or not known yet if the trigger itself is not known
+	newState := types.StringValue("{}")
+	if plan.ResetStateOnChange.IsUnknown() {
+		newState = types.StringUnknown()
+	}
+
+	plan.State = newState
+	if !plan.Rows.IsNull() && !plan.Rows.IsUnknown() {
+		rows := []*RowModel{}
+		resp.Diagnostics.Append(plan.Rows.ElementsAs(ctx, &rows, false)...)
+		for _, row := range rows {
+			row.State = newState
+		}
+		resp.Diagnostics = updateRowsInModel(ctx, &plan, rows, resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// mergeIgnoredPaths reads the remote configuration and copies its values at the ignored paths into the payload.
+func (r *Resource) mergeIgnoredPaths(
+	ctx context.Context,
+	state, plan ConfigModel,
+	payload *keboola.ConfigWithRows,
+) error {
+	remote, err := r.readConfigWithRows(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	mapper, _ := r.base.Mapper.(*ConfigMapper)
+
+	return mapper.MergeIgnoredPaths(ctx, plan, payload, remote)
+}
+
+// IdentitySchema defines the identity of the resource, the parts of the compound ID.
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"branch_id": identityschema.Int64Attribute{
+				Description:       "Id of the branch.",
+				RequiredForImport: true,
+			},
+			"component_id": identityschema.StringAttribute{
+				Description:       "Id of the component.",
+				RequiredForImport: true,
+			},
+			"configuration_id": identityschema.StringAttribute{
+				Description:       "Id of the configuration.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ImportState imports an existing configuration by the ID in the branchId/componentId/configId format,
+// or by the identity. The key attributes are set, the rest of the state is filled by the following Read.
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var key keboola.ConfigKey
+	if req.ID != "" {
+		var err error
+		if key, err = ParseConfigModelID(req.ID); err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", err.Error())
+
+			return
+		}
+	} else {
+		var identity IdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		key = keboola.ConfigKey{
+			BranchID:    keboola.BranchID(identity.BranchID.ValueInt64()),
+			ComponentID: keboola.ComponentID(identity.ComponentID.ValueString()),
+			ID:          keboola.ConfigID(identity.ConfigID.ValueString()),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), FormatConfigModelID(key))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch_id"), int64(key.BranchID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("component_id"), key.ComponentID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("configuration_id"), key.ID.String())...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, NewIdentityModel(key))...)
+	}
+}
+
 // Delete deletes the resource.
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	tflog.Info(ctx, "Deleting configuration resource")
@@ -348,12 +710,19 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 			ComponentID: keboola.ComponentID(state.ComponentID.ValueString()),
 		}
 
-		// Delete the configuration
-		err := r.client.DeleteConfigRequest(key).SendOrErr(ctx)
+		// Delete the configuration, it is moved to trash
+		err := r.configs.DeleteConfig(ctx, key)
 		if err != nil {
 			return fmt.Errorf("could not delete configuration: %w", err)
 		}
 
+		// Deleting the configuration in trash deletes it permanently
+		if state.PurgeOnDestroy.ValueBool() {
+			if err := r.configs.DeleteConfig(ctx, key); err != nil {
+				return fmt.Errorf("could not purge configuration from trash: %w", err)
+			}
+		}
+
 		return nil
 	})
 }
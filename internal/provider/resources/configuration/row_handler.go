@@ -9,13 +9,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/keboola/go-utils/pkg/orderedmap"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
 )
 
 // DefaultConfigRowHandler implements ConfigRowHandler interface.
 type DefaultConfigRowHandler struct {
-	// Pointer to the parent resource's client
-	Client *keboola.AuthorizedAPI
-	isTest bool
+	// The parent resource's configurations client
+	Configs apiclient.Configs
+	isTest  bool
 }
 
 // ExtractChildModels extracts row models from the parent configuration model.
@@ -82,6 +84,84 @@ func (h *DefaultConfigRowHandler) MapChildModelsToAPI(
 	return rows, nil
 }
 
+// ReconcileRestoredRows updates the rows of a configuration restored from trash to the planned rows
+// and returns the IDs of the planned rows in order.
+// The planned rows are matched with the restored rows by ID, and the rows without ID in order,
+// like the new rows in ProcessAPIChildModels. The unmatched planned rows are created,
+// the unmatched restored rows are deleted.
+func (h *DefaultConfigRowHandler) ReconcileRestoredRows(
+	ctx context.Context,
+	key keboola.ConfigKey,
+	planned []*keboola.ConfigRow,
+) ([]string, error) {
+	rowKey := keboola.ConfigRowKey{BranchID: key.BranchID, ComponentID: key.ComponentID, ConfigID: key.ID}
+	restored, err := h.Configs.ListConfigRows(ctx, rowKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not read restored configuration rows: %w", err)
+	}
+
+	unmatched := make(map[keboola.RowID]bool, len(restored))
+	for _, row := range restored {
+		unmatched[row.ID] = true
+	}
+
+	// Match the rows with ID first, so they are not taken by the rows without ID
+	matched := make([]bool, len(planned))
+	for i, row := range planned {
+		if row.ID != "" && unmatched[row.ID] {
+			matched[i] = true
+			delete(unmatched, row.ID)
+		}
+	}
+
+	next := 0
+	for i, row := range planned {
+		if row.ID != "" {
+			continue
+		}
+
+		for next < len(restored) && !unmatched[restored[next].ID] {
+			next++
+		}
+
+		if next < len(restored) {
+			row.ID = restored[next].ID
+			matched[i] = true
+			delete(unmatched, row.ID)
+		}
+	}
+
+	rowsSortOrder := make([]string, 0, len(planned))
+	for i, row := range planned {
+		row.BranchID, row.ComponentID, row.ConfigID = key.BranchID, key.ComponentID, key.ID
+
+		result := row
+		if matched[i] {
+			_, err = h.Configs.UpdateConfigRow(ctx, row, nil)
+		} else {
+			result, err = h.Configs.CreateConfigRow(ctx, row)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not update restored configuration row: %w", err)
+		}
+
+		rowsSortOrder = append(rowsSortOrder, result.ID.String())
+	}
+
+	for _, row := range restored {
+		if !unmatched[row.ID] {
+			continue
+		}
+
+		if err := h.Configs.DeleteConfigRow(ctx, row.ConfigRowKey); err != nil {
+			return nil, fmt.Errorf("could not delete restored configuration row %s: %w", row.ID, err)
+		}
+	}
+
+	return rowsSortOrder, nil
+}
+
 // ProcessAPIChildModels processes row API models after API operations.
 func (h *DefaultConfigRowHandler) ProcessAPIChildModels(
 	ctx context.Context,
@@ -103,6 +183,9 @@ func (h *DefaultConfigRowHandler) ProcessAPIChildModels(
 	// Process the rows into Terraform models
 	var originalRows []RowModel
 
+	// Rows without ID are new, they are matched with the remaining API rows in order
+	var newRows []RowModel
+
 	// If parent already has rows defined, preserve their order
 	if !parent.Rows.IsNull() {
 		var existingRows []RowModel
@@ -113,10 +196,16 @@ func (h *DefaultConfigRowHandler) ProcessAPIChildModels(
 
 		// Process existing rows first, maintaining their order
 		for _, existingRow := range existingRows {
+			if existingRow.ID.IsNull() || existingRow.ID.IsUnknown() {
+				newRows = append(newRows, existingRow)
+
+				continue
+			}
+
 			rowID := existingRow.ID.String()
 			if apiRow, ok := rowMap[rowID]; ok {
 				// Row exists in API response
-				rowModel := createRowModelFromAPI(apiRow, h.isTest)
+				rowModel := h.createRowModel(ctx, apiRow, &existingRow, &diags)
 				originalRows = append(originalRows, rowModel)
 
 				// Remove from map to track processed rows
@@ -129,7 +218,12 @@ func (h *DefaultConfigRowHandler) ProcessAPIChildModels(
 	for _, apiRow := range apiRows {
 		rowID := apiRow.ID.String()
 		if _, ok := rowMap[rowID]; ok {
-			rowModel := createRowModelFromAPI(apiRow, h.isTest)
+			var prior *RowModel
+			if len(newRows) > 0 {
+				prior, newRows = &newRows[0], newRows[1:]
+			}
+
+			rowModel := h.createRowModel(ctx, apiRow, prior, &diags)
 			originalRows = append(originalRows, rowModel)
 		}
 	}
@@ -155,6 +249,26 @@ func (h *DefaultConfigRowHandler) ProcessAPIChildModels(
 	return diags
 }
 
+// createRowModel creates a RowModel from API row, keeping the ignored paths of the prior row model, if any.
+func (h *DefaultConfigRowHandler) createRowModel(
+	ctx context.Context,
+	apiRow *keboola.ConfigRow,
+	prior *RowModel,
+	diags *diag.Diagnostics,
+) RowModel {
+	if prior == nil {
+		return createRowModelFromAPI(apiRow, h.isTest)
+	}
+
+	row := *apiRow
+	row.Content = keepIgnoredPaths(ctx, apiRow.Content, prior.Content, prior.IgnorePaths, diags)
+
+	rowModel := createRowModelFromAPI(&row, h.isTest)
+	rowModel.IgnorePaths = prior.IgnorePaths
+
+	return rowModel
+}
+
 // Helper function to create a RowModel from API row.
 func createRowModelFromAPI(apiRow *keboola.ConfigRow, isTest bool) RowModel {
 	rowModel := RowModel{ //nolint: exhaustruct
@@ -163,6 +277,7 @@ func createRowModelFromAPI(apiRow *keboola.ConfigRow, isTest bool) RowModel {
 		IsDisabled:        types.BoolValue(apiRow.IsDisabled),
 		Description:       types.StringValue(apiRow.Description),
 		ChangeDescription: types.StringValue(apiRow.ChangeDescription),
+		IgnorePaths:       types.ListNull(types.StringType),
 	}
 
 	// Handle row state and content
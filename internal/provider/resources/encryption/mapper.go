@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 )
 
 // EncryptResponse is a simple wrapper around the map response from the API.
@@ -13,7 +12,6 @@ type EncryptResponse map[string]string
 
 // Mapper implements ResourceMapper for encryption resources.
 type Mapper struct {
-	client    *keboola.AuthorizedAPI
 	projectID int
 }
 
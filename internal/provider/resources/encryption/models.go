@@ -1,13 +1,15 @@
 package encryption
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Model defines the encryption resource model.
 type Model struct {
-	ID             types.String `tfsdk:"id"`
-	ComponentID    types.String `tfsdk:"component_id"`
-	Value          types.String `tfsdk:"value"`
-	EncryptedValue types.String `tfsdk:"encrypted_value"`
+	ID             types.String   `tfsdk:"id"`
+	ComponentID    types.String   `tfsdk:"component_id"`
+	Value          types.String   `tfsdk:"value"`
+	EncryptedValue types.String   `tfsdk:"encrypted_value"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
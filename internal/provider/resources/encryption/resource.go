@@ -13,6 +13,7 @@ import (
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 
 	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
 	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
 )
 
@@ -25,10 +26,10 @@ var (
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource = &Resource{
-		base: abstraction.BaseResource[Model, *EncryptResponse]{}, client: nil, projectID: 0,
+		base: abstraction.BaseResource[Model, *EncryptResponse]{}, encryption: nil, projectID: 0,
 	}
 	_ resource.ResourceWithConfigure = &Resource{
-		base: abstraction.BaseResource[Model, *EncryptResponse]{}, client: nil, projectID: 0,
+		base: abstraction.BaseResource[Model, *EncryptResponse]{}, encryption: nil, projectID: 0,
 	}
 )
 
@@ -37,9 +38,9 @@ type Resource struct {
 	// Base functionality with encryption model specifics
 	base abstraction.BaseResource[Model, *EncryptResponse]
 
-	// Direct access to the API client for specific operations
-	client    *keboola.AuthorizedAPI
-	projectID int
+	// API client for specific operations
+	encryption apiclient.Encryption
+	projectID  int
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -53,14 +54,16 @@ func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res
 }
 
 // Schema defines the schema for the resource.
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server
 		MarkdownDescription: "Encryption resource",
 		Description:         "Encryption resource for securely storing sensitive data in Keboola",
 		DeprecationMessage:  "",
 		Version:             1,
-		Blocks:              map[string]schema.Block{},
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -74,6 +77,9 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 				MarkdownDescription: "Id of the component where the encrypted value will be used.",
 				Description:         "Id of the component where the encrypted value will be used.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"value": schema.StringAttribute{
 				MarkdownDescription: "Value to be encrypted.",
@@ -101,12 +107,14 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, _
 	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
 
 	// Set up the API client
-	r.client = providerData.Client
+	r.encryption = providerData.Encryption
 	r.projectID = providerData.Token.ProjectID()
 
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
 	// Set up the mapper
 	r.base.Mapper = &Mapper{
-		client:    r.client,
 		projectID: r.projectID,
 	}
 }
@@ -123,16 +131,17 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		}
 
 		// Call the API to encrypt the value
-		result, err := r.client.EncryptRequest(
+		result, err := r.encryption.Encrypt(
+			ctx,
 			r.projectID,
 			keboola.ComponentID(model.ComponentID.ValueString()),
 			requestBody,
-		).Send(ctx)
+		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to encrypt value: %w", err)
 		}
 
-		response := EncryptResponse(*result)
+		response := EncryptResponse(result)
 
 		return &response, nil
 	})
@@ -175,17 +184,18 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		}
 
 		// Call the API to encrypt the value
-		result, err := r.client.EncryptRequest(
+		result, err := r.encryption.Encrypt(
+			ctx,
 			r.projectID,
 			keboola.ComponentID(plan.ComponentID.ValueString()),
 			requestBody,
-		).Send(ctx)
+		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to encrypt value: %w", err)
 		}
 
 		// Convert to our custom response type
-		response := EncryptResponse(*result)
+		response := EncryptResponse(result)
 
 		return &response, nil
 	})
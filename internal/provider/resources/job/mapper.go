@@ -0,0 +1,107 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+)
+
+// Mapper implements ResourceMapper for job resources.
+type Mapper struct{}
+
+// MapAPIToTerraform converts a Job Queue job to a Terraform model, the inputs of the job are kept.
+func (m *Mapper) MapAPIToTerraform(
+	_ context.Context,
+	apiModel *keboola.QueueJob,
+	tfModel *Model,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfModel.ID = types.StringValue(apiModel.ID.String())
+	tfModel.BranchID = types.Int64Value(int64(apiModel.BranchID))
+	tfModel.ComponentID = types.StringValue(apiModel.ComponentID.String())
+	tfModel.ConfigID = types.StringValue(apiModel.ConfigID.String())
+	tfModel.Status = types.StringValue(apiModel.Status)
+	tfModel.URL = types.StringValue(apiModel.URL)
+	tfModel.ResultMessage = types.StringValue(apiModel.Result.Message)
+
+	tfModel.StartTime = types.StringNull()
+	if apiModel.StartTime != nil {
+		tfModel.StartTime = types.StringValue(apiModel.StartTime.UTC().Format(time.RFC3339))
+	}
+
+	tfModel.EndTime = types.StringNull()
+	if apiModel.EndTime != nil {
+		tfModel.EndTime = types.StringValue(apiModel.EndTime.UTC().Format(time.RFC3339))
+	}
+
+	// The duration is known once the job has both started and ended
+	tfModel.DurationSeconds = types.Int64Null()
+	if apiModel.StartTime != nil && apiModel.EndTime != nil {
+		duration := apiModel.EndTime.Sub(apiModel.StartTime.Time)
+		tfModel.DurationSeconds = types.Int64Value(int64(duration.Seconds()))
+	}
+
+	return diags
+}
+
+// MapTerraformToAPI converts a Terraform model to the key of the job.
+func (m *Mapper) MapTerraformToAPI(
+	_ context.Context,
+	stateModel, tfModel Model,
+) (*keboola.QueueJob, error) {
+	job := &keboola.QueueJob{}
+	job.ID = keboola.JobID(stateModel.ID.ValueString())
+	job.BranchID = keboola.BranchID(tfModel.BranchID.ValueInt64())
+	job.ComponentID = keboola.ComponentID(tfModel.ComponentID.ValueString())
+	job.ConfigID = keboola.ConfigID(tfModel.ConfigID.ValueString())
+
+	return job, nil
+}
+
+// ValidateTerraformModel validates a Terraform model against constraints.
+func (m *Mapper) ValidateTerraformModel(
+	_ context.Context,
+	_, newModel *Model,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if newModel.ConfigID.ValueString() == "" {
+		diags.AddError(
+			"Invalid Configuration",
+			"configuration_id must not be empty",
+		)
+	}
+
+	return diags
+}
+
+// jobRequest converts the planned Terraform model to the request of a new job.
+func jobRequest(ctx context.Context, plan Model) (apiclient.JobRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := apiclient.JobRequest{
+		BranchID:    keboola.BranchID(plan.BranchID.ValueInt64()),
+		ComponentID: keboola.ComponentID(plan.ComponentID.ValueString()),
+		ConfigID:    keboola.ConfigID(plan.ConfigID.ValueString()),
+	}
+
+	if !plan.RowIDs.IsNull() {
+		var rowIDs []string
+		diags.Append(plan.RowIDs.ElementsAs(ctx, &rowIDs, false)...)
+		for _, id := range rowIDs {
+			request.RowIDs = append(request.RowIDs, keboola.RowID(id))
+		}
+	}
+
+	if !plan.VariableValues.IsNull() {
+		diags.Append(plan.VariableValues.ElementsAs(ctx, &request.VariableValues, false)...)
+	}
+
+	return request, diags
+}
@@ -0,0 +1,24 @@
+package job
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model defines the job resource model.
+type Model struct {
+	ID              types.String   `tfsdk:"id"`
+	BranchID        types.Int64    `tfsdk:"branch_id"`
+	ComponentID     types.String   `tfsdk:"component_id"`
+	ConfigID        types.String   `tfsdk:"configuration_id"`
+	RowIDs          types.List     `tfsdk:"row_ids"`
+	VariableValues  types.Map      `tfsdk:"variable_values"`
+	Triggers        types.Map      `tfsdk:"triggers"`
+	Status          types.String   `tfsdk:"status"`
+	URL             types.String   `tfsdk:"url"`
+	ResultMessage   types.String   `tfsdk:"result_message"`
+	StartTime       types.String   `tfsdk:"start_time"`
+	EndTime         types.String   `tfsdk:"end_time"`
+	DurationSeconds types.Int64    `tfsdk:"duration_seconds"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
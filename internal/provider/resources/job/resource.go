@@ -0,0 +1,305 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.QueueJob]{}, jobs: nil, branches: nil,
+	}
+	_ resource.ResourceWithConfigure = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.QueueJob]{}, jobs: nil, branches: nil,
+	}
+)
+
+// Resource runs a configuration as a Job Queue job and waits for it.
+// The job is run when the resource is created or replaced, e.g. when the triggers change.
+type Resource struct {
+	// Base functionality with job model specifics
+	base abstraction.BaseResource[Model, *keboola.QueueJob]
+
+	// API clients for specific operations
+	jobs     apiclient.Jobs
+	branches apiclient.Branches
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() *Resource {
+	return &Resource{}
+}
+
+// Metadata returns the resource type name.
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema defines the schema for the resource.
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a configuration as a Job Queue job and waits until the job finishes. " +
+			"The job runs when the resource is created, a change of any argument, e.g. of triggers, runs a new job. " +
+			"The apply fails if the job does not succeed, the resource is then tainted and the job runs again " +
+			"on the next apply. Destroying the resource does not affect the job.",
+		MarkdownDescription: "Runs a configuration as a [Job Queue](https://help.keboola.com/management/jobs/) " +
+			"job and waits until the job finishes.\n\n" +
+			"The job runs when the resource is created, a change of any argument, e.g. of `triggers`, runs a new job. " +
+			"The apply fails if the job does not succeed, the resource is then tainted and the job runs again " +
+			"on the next apply. Destroying the resource does not affect the job.",
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
+		DeprecationMessage: "",
+		Version:            0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"branch_id": schema.Int64Attribute{
+				Description: "Id of the branch. If not specified, then default branch will be used.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"component_id": schema.StringAttribute{
+				Description: "Id of the component.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configuration_id": schema.StringAttribute{
+				Description: "Id of the configuration to run.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"row_ids": schema.ListAttribute{
+				Description: "IDs of the configuration rows to run. If not specified, then all enabled rows run.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"variable_values": schema.MapAttribute{
+				Description: "Values of the configuration variables, by the variable name.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values, a change runs a new job, " +
+					"e.g. the version of the configuration to run the job after each change.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Final status of the job, e.g. success or warning.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the job in the Job Queue API.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"result_message": schema.StringAttribute{
+				Description: "Result message of the job, e.g. the error message of a failed job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Description: "RFC 3339 timestamp when the job started.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Description: "RFC 3339 timestamp when the job ended.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Description: "Duration of the job in seconds.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured clients to the resource.
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+	r.jobs = providerData.Jobs
+	r.branches = providerData.Branches
+
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
+	// Default operation timeouts, overridable by the timeouts block.
+	// The create waits for the job, so it is as long as a typical job may run.
+	r.base.Timeouts = abstraction.Timeouts{
+		Create: 60 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 5 * time.Minute,
+		Delete: 5 * time.Minute,
+	}
+
+	// Set up the mapper
+	r.base.Mapper = &Mapper{}
+}
+
+// Create runs the job and waits until it finishes.
+// A job which does not succeed, or cannot be waited for, is stored in the state,
+// so the resource is tainted and replaced by the next apply instead of running another job next to it.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating job resource")
+
+	var jobErr *abstraction.QueueJobError
+
+	// Use the base resource abstraction for Create
+	r.base.ExecuteCreate(ctx, req, resp, func(ctx context.Context, plan Model) (*keboola.QueueJob, error) {
+		// Handle default branch if not specified
+		if plan.BranchID.IsUnknown() {
+			branch, err := r.branches.GetDefaultBranch(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not get default branch: %w", err)
+			}
+			plan.BranchID = types.Int64Value(int64(branch.ID))
+		}
+
+		request, diags := jobRequest(ctx, plan)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to map Terraform model to API: %v", diags)
+		}
+
+		job, err := r.jobs.CreateJob(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("could not create job: %w", err)
+		}
+
+		tflog.Info(ctx, "Created job, waiting for it to finish", map[string]any{"job_id": job.ID.String()})
+
+		finished, err := abstraction.WaitForQueueJob(ctx, r.jobs, job)
+		if errors.As(err, &jobErr) {
+			// The job is stored and the error is reported below,
+			// the last known state is stored if the job has not finished, e.g. on the create timeout
+			if finished != nil {
+				return finished, nil
+			}
+
+			return job, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not wait for job: %w", err)
+		}
+
+		return finished, nil
+	})
+
+	if jobErr != nil && !resp.Diagnostics.HasError() {
+		if jobErr.Err != nil {
+			resp.Diagnostics.AddError("Job did not finish", jobErr.Error())
+		} else {
+			resp.Diagnostics.AddError("Job failed", jobErr.Error())
+		}
+	}
+}
+
+// Read refreshes the status of the job.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading job resource")
+
+	// Use the base resource abstraction for Read
+	r.base.ExecuteRead(ctx, req, resp, func(ctx context.Context, state Model) (*keboola.QueueJob, error) {
+		return r.getJob(ctx, state)
+	})
+}
+
+// Update updates only the timeouts, all arguments of the job require a new job.
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating job resource")
+
+	// Use the base resource abstraction for Update
+	r.base.ExecuteUpdate(ctx, req, resp, func(ctx context.Context, state, _ Model) (*keboola.QueueJob, error) {
+		return r.getJob(ctx, state)
+	})
+}
+
+// Delete removes the job from the state, the job itself cannot be deleted.
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting job resource")
+
+	// Use the base resource abstraction for Delete
+	r.base.ExecuteDelete(ctx, req, resp, func(_ context.Context, _ Model) error {
+		return nil
+	})
+}
+
+// getJob gets the job of the state, a job removed by the API retention is not found.
+func (r *Resource) getJob(ctx context.Context, state Model) (*keboola.QueueJob, error) {
+	job, err := r.jobs.GetJob(ctx, keboola.JobKey{ID: keboola.JobID(state.ID.ValueString())})
+
+	var apiErr interface{ StatusCode() int }
+	if errors.As(err, &apiErr) && apiErr.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("job %s: %w", state.ID.ValueString(), abstraction.ErrResourceNotFound)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get job %s: %w", state.ID.ValueString(), err)
+	}
+
+	return job, nil
+}
@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &ListResource{schedules: nil, mapper: nil}
+	_ list.ListResourceWithConfigure = &ListResource{schedules: nil, mapper: nil}
+)
+
+// ListResource lists the schedules of the project for terraform query.
+// The identity of each schedule is the identity of the scheduler resource.
+type ListResource struct {
+	schedules apiclient.Schedules
+	mapper    *Mapper
+}
+
+// ListModel defines the configuration of the list block.
+type ListModel struct {
+	ConfigID types.String `tfsdk:"configuration_id"`
+}
+
+// NewListResource is a helper function to simplify the provider implementation.
+func NewListResource() *ListResource {
+	return &ListResource{}
+}
+
+// Metadata returns the type name of the listed resource.
+func (l *ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduler"
+}
+
+// ListResourceConfigSchema defines the schema of the list block.
+func (l *ListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the schedules of the project.",
+		Attributes: map[string]listschema.Attribute{
+			"configuration_id": listschema.StringAttribute{
+				Description: "Id of the scheduler configuration. If specified, only its schedules are listed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (l *ListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+	l.schedules = providerData.Schedules
+
+	// The listed resources are mapped the same way as by the resource
+	l.mapper = &Mapper{
+		isTest: os.Getenv("TF_ACC") != "", //nolint: forbidigo
+	}
+}
+
+// List streams the schedules sorted by ID.
+func (l *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Info(ctx, "Listing schedules")
+
+	var model ListModel
+	diags := req.Config.Get(ctx, &model)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	schedules, err := l.schedules.ListSchedules(ctx)
+	if err != nil {
+		diags.AddError("Error listing schedules", "could not list schedules: "+err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	schedules = slices.DeleteFunc(schedules, func(schedule *keboola.Schedule) bool {
+		return !model.ConfigID.IsNull() && string(schedule.ConfigID) != model.ConfigID.ValueString()
+	})
+	slices.SortFunc(schedules, func(a, b *keboola.Schedule) int { return strings.Compare(string(a.ID), string(b.ID)) })
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, schedule := range schedules {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = string(schedule.ID)
+			result.Diagnostics.Append(result.Identity.Set(ctx, IdentityModel{ID: types.StringValue(string(schedule.ID))})...)
+			if req.IncludeResource {
+				l.setResource(ctx, schedule, &result)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// setResource sets the resource of the result, the same state as the import of the schedule followed by a read.
+func (l *ListResource) setResource(ctx context.Context, schedule *keboola.Schedule, result *list.ListResult) {
+	// The ID sets the other attributes to null values of their types
+	result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), string(schedule.ID))...)
+
+	var model Model
+	result.Diagnostics.Append(result.Resource.Get(ctx, &model)...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Diagnostics.Append(l.mapper.MapAPIToTerraform(ctx, schedule, &model)...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+}
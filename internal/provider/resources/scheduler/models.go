@@ -1,14 +1,22 @@
 package scheduler
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Model represents the Terraform schema for a scheduler.
 type Model struct {
-	ID                   types.String `tfsdk:"id"`
-	ConfigID             types.String `tfsdk:"configuration_id"`
-	ConfigurationVersion types.String `tfsdk:"configuration_version"`
+	ID                   types.String   `tfsdk:"id"`
+	ConfigID             types.String   `tfsdk:"configuration_id"`
+	ConfigurationVersion types.String   `tfsdk:"configuration_version"`
+	DeletionProtection   types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// IdentityModel is the resource identity of a schedule.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 // GetSchedulerModelID returns the ID for the scheduler model.
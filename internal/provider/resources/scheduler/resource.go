@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -14,6 +17,7 @@ import (
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 
 	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
 	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
 )
 
@@ -23,14 +27,24 @@ var ErrConfigIDRequired = errors.New("config_id is required for scheduler creati
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource = &Resource{
-		base:   abstraction.BaseResource[Model, *keboola.Schedule]{},
-		client: nil,
-		isTest: false,
+		base:      abstraction.BaseResource[Model, *keboola.Schedule]{},
+		schedules: nil,
+		isTest:    false,
 	}
 	_ resource.ResourceWithConfigure = &Resource{
-		base:   abstraction.BaseResource[Model, *keboola.Schedule]{},
-		client: nil,
-		isTest: false,
+		base:      abstraction.BaseResource[Model, *keboola.Schedule]{},
+		schedules: nil,
+		isTest:    false,
+	}
+	_ resource.ResourceWithImportState = &Resource{
+		base:      abstraction.BaseResource[Model, *keboola.Schedule]{},
+		schedules: nil,
+		isTest:    false,
+	}
+	_ resource.ResourceWithIdentity = &Resource{
+		base:      abstraction.BaseResource[Model, *keboola.Schedule]{},
+		schedules: nil,
+		isTest:    false,
 	}
 )
 
@@ -39,17 +53,17 @@ type Resource struct {
 	// Base functionality with scheduler model specifics
 	base abstraction.BaseResource[Model, *keboola.Schedule]
 
-	// Direct access to the API client for specific operations
-	client *keboola.AuthorizedAPI
-	isTest bool
+	// API client for specific operations
+	schedules apiclient.Schedules
+	isTest    bool
 }
 
 // NewResource is a helper function to simplify the provider implementation.
 func NewResource() *Resource {
 	return &Resource{
-		base:   abstraction.BaseResource[Model, *keboola.Schedule]{},
-		client: nil,
-		isTest: false,
+		base:      abstraction.BaseResource[Model, *keboola.Schedule]{},
+		schedules: nil,
+		isTest:    false,
 	}
 }
 
@@ -59,14 +73,17 @@ func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res
 }
 
 // Schema defines the schema for the resource.
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description:         "Manages scheduler configurations.",
 		MarkdownDescription: "Manages scheduler configurations.",
-		Blocks:              map[string]schema.Block{},
-		DeprecationMessage:  "",
-		Version:             1,
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
+		DeprecationMessage: "",
+		Version:            1,
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": abstraction.DeletionProtectionAttribute(),
 			"id": schema.StringAttribute{
 				Description:         "Unique identifier of the scheduler.",
 				MarkdownDescription: "Unique identifier of the scheduler.",
@@ -79,6 +96,9 @@ func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *res
 				Description:         "ID of the configuration that is scheduled to run.",
 				MarkdownDescription: "ID of the configuration that is scheduled to run.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"configuration_version": schema.StringAttribute{
 				Description:         "Version of the configuration to run.",
@@ -103,13 +123,29 @@ func (r *Resource) Configure(
 
 	// Get the provider data - ignoring the type assertion success
 	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
-	r.client = providerData.Client
+	r.schedules = providerData.Schedules
 	r.isTest = os.Getenv("TF_ACC") != "" //nolint: forbidigo
 
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
+	// Default operation timeouts, overridable by the timeouts block
+	r.base.Timeouts = abstraction.Timeouts{
+		Create: 10 * time.Minute,
+		Read:   5 * time.Minute,
+		Update: 10 * time.Minute,
+		Delete: 5 * time.Minute,
+	}
+
 	// Set up the mapper
 	r.base.Mapper = &Mapper{
 		isTest: r.isTest,
 	}
+
+	// The identity is the schedule ID
+	r.base.Identity = func(model Model) any {
+		return IdentityModel{ID: model.ID}
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -132,7 +168,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 		// Attempt to activate/create the schedule
 		configID := keboola.ConfigID(plan.ConfigID.ValueString())
-		schedule, err := r.client.ActivateScheduleRequest(configID, configVersionID).Send(ctx)
+		schedule, err := r.schedules.ActivateSchedule(ctx, configID, configVersionID)
 		if err != nil {
 			return nil, fmt.Errorf("could not create scheduler using ActivateScheduleRequest: %w", err)
 		}
@@ -154,11 +190,9 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	// Use the base resource abstraction for Read
 	r.base.ExecuteRead(ctx, req, resp, func(ctx context.Context, state Model) (*keboola.Schedule, error) {
 		// Get all schedules and find the one with matching ID
-		schedule, err := r.client.GetScheduleRequest(
-			keboola.ScheduleKey{
-				ID: keboola.ScheduleID(state.ID.ValueString()),
-			},
-		).Send(ctx)
+		schedule, err := r.schedules.GetSchedule(ctx, keboola.ScheduleKey{
+			ID: keboola.ScheduleID(state.ID.ValueString()),
+		})
 		if err != nil {
 			return nil, fmt.Errorf("could not get schedule: %w", err)
 		}
@@ -201,10 +235,7 @@ func (r *Resource) Update(
 				configVersionID = plan.ConfigurationVersion.ValueString()
 			}
 
-			resSchedule, err := r.client.ActivateScheduleRequest(
-				apiModel.ConfigID,
-				configVersionID,
-			).Send(ctx)
+			resSchedule, err := r.schedules.ActivateSchedule(ctx, apiModel.ConfigID, configVersionID)
 			if err != nil {
 				return nil, fmt.Errorf("could not activate scheduler: %w", err)
 			}
@@ -225,7 +256,7 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		}
 
 		// Delete the scheduler
-		err := r.client.DeleteScheduleRequest(key).SendOrErr(ctx)
+		err := r.schedules.DeleteSchedule(ctx, key)
 		if err != nil {
 			return fmt.Errorf("could not delete scheduler: %w", err)
 		}
@@ -233,3 +264,32 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return nil
 	})
 }
+
+// IdentitySchema defines the identity of the resource, the schedule ID.
+func (r *Resource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "Id of the schedule.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ImportState imports an existing schedule by its ID or by the identity,
+// the rest of the state is filled by the following Read.
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	if resp.Diagnostics.HasError() || resp.Identity == nil {
+		return
+	}
+
+	var identity IdentityModel
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &identity.ID)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
@@ -0,0 +1,95 @@
+package metadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// Mapper implements ResourceMapper for storage metadata resources.
+type Mapper struct{}
+
+// MapAPIToTerraform converts a metadata Entry to a Terraform model.
+func (m *Mapper) MapAPIToTerraform(
+	_ context.Context,
+	apiModel *Entry,
+	tfModel *Model,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfModel.BranchID = types.Int64Value(int64(apiModel.BranchID))
+	tfModel.ID = types.StringValue(apiModel.Detail.ID)
+	tfModel.Key = types.StringValue(apiModel.Detail.Key)
+	tfModel.Value = types.StringValue(apiModel.Detail.Value)
+	if apiModel.Detail.Provider != "" {
+		tfModel.Provider = types.StringValue(apiModel.Detail.Provider)
+	}
+
+	return diags
+}
+
+// MapTerraformToAPI converts a Terraform storage metadata model to a metadata Entry.
+func (m *Mapper) MapTerraformToAPI(
+	_ context.Context,
+	_ Model,
+	tfModel Model,
+) (*Entry, error) {
+	return &Entry{
+		BranchID: keboola.BranchID(tfModel.BranchID.ValueInt64()),
+		Detail: &keboola.MetadataDetail{
+			ID:       tfModel.ID.ValueString(),
+			Key:      tfModel.Key.ValueString(),
+			Value:    tfModel.Value.ValueString(),
+			Provider: tfModel.Provider.ValueString(),
+		},
+	}, nil
+}
+
+// ValidateTerraformModel validates a Terraform storage metadata model.
+func (m *Mapper) ValidateTerraformModel(
+	_ context.Context,
+	_ *Model,
+	newModel *Model,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Exactly one of bucket_id and table_id must be set
+	hasBucket := !newModel.BucketID.IsNull() && newModel.BucketID.ValueString() != ""
+	hasTable := !newModel.TableID.IsNull() && newModel.TableID.ValueString() != ""
+	if hasBucket == hasTable {
+		diags.AddError(
+			"Error validating storage metadata resource",
+			"Exactly one of bucket_id and table_id must be set",
+		)
+	}
+
+	// Column metadata is always bound to a table
+	if !newModel.ColumnName.IsNull() && newModel.ColumnName.ValueString() != "" && !hasTable {
+		diags.AddAttributeError(
+			path.Root("column_name"),
+			"Error validating storage metadata resource",
+			"column_name can only be used together with table_id",
+		)
+	}
+
+	// Key is required
+	if newModel.Key.IsUnknown() || newModel.Key.IsNull() {
+		diags.AddError(
+			"Error validating storage metadata resource",
+			"Key is required",
+		)
+	}
+
+	// Value is required
+	if newModel.Value.IsUnknown() || newModel.Value.IsNull() {
+		diags.AddError(
+			"Error validating storage metadata resource",
+			"Value is required",
+		)
+	}
+
+	return diags
+}
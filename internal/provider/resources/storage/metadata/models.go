@@ -0,0 +1,27 @@
+package metadata
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// Model defines the storage metadata resource model.
+type Model struct {
+	ID                 types.String   `tfsdk:"id"`
+	BranchID           types.Int64    `tfsdk:"branch_id"`
+	BucketID           types.String   `tfsdk:"bucket_id"`
+	TableID            types.String   `tfsdk:"table_id"`
+	ColumnName         types.String   `tfsdk:"column_name"`
+	Provider           types.String   `tfsdk:"metadata_provider"`
+	Key                types.String   `tfsdk:"key"`
+	Value              types.String   `tfsdk:"value"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Entry is the API representation of the resource, a metadata detail together with the branch of its target.
+type Entry struct {
+	BranchID keboola.BranchID
+	Detail   *keboola.MetadataDetail
+}
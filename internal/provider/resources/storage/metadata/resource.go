@@ -0,0 +1,249 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+// DefaultProvider is the metadata provider used by the Keboola UI, e.g. for KBC.description.
+const DefaultProvider = "user"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &Resource{
+		base: abstraction.BaseResource[Model, *Entry]{}, metadata: nil, branches: nil,
+	}
+	_ resource.ResourceWithConfigure = &Resource{
+		base: abstraction.BaseResource[Model, *Entry]{}, metadata: nil, branches: nil,
+	}
+)
+
+// Resource is the storage metadata resource implementation.
+type Resource struct {
+	// Base functionality with metadata model specifics
+	base abstraction.BaseResource[Model, *Entry]
+
+	// API clients for specific operations
+	metadata apiclient.Metadata
+	branches apiclient.Branches
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() *Resource {
+	return &Resource{}
+}
+
+// Metadata returns the resource type name.
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_metadata"
+}
+
+// Schema defines the schema for the resource.
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server
+		MarkdownDescription: "Manages a single metadata entry of a storage bucket, table or table column.",
+		Description:         "Manages a single metadata entry of a storage bucket, table or table column.",
+		DeprecationMessage:  "",
+		Version:             1,
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"deletion_protection": abstraction.DeletionProtectionAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Metadata ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"branch_id": schema.Int64Attribute{
+				MarkdownDescription: "Id of the branch. If not specified, then default branch will be used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"bucket_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the bucket, e.g. `in.c-main`. Conflicts with `table_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the table, e.g. `in.c-main.users`. Conflicts with `bucket_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the table column. If set, the metadata is attached to the column of `table_id`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata_provider": schema.StringAttribute{
+				MarkdownDescription: "Metadata provider namespace. Defaults to `" + DefaultProvider + "`, " +
+					"which is used by the Keboola UI, e.g. for `KBC.description`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(DefaultProvider),
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Metadata key",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Metadata value",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+
+	// Set up the API clients
+	r.metadata = providerData.Metadata
+	r.branches = providerData.Branches
+
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
+	// Set up the mapper
+	r.base.Mapper = &Mapper{}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating storage metadata resource")
+
+	// Use the base resource abstraction for Create
+	r.base.ExecuteCreate(ctx, req, resp, func(ctx context.Context, model Model) (*Entry, error) {
+		// Handle default branch if not specified
+		if model.BranchID.IsUnknown() || model.BranchID.IsNull() {
+			branch, err := r.branches.GetDefaultBranch(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not get default branch: %w", err)
+			}
+			model.BranchID = types.Int64Value(int64(branch.ID))
+		}
+
+		target, err := newTarget(r.metadata, model)
+		if err != nil {
+			return nil, err
+		}
+
+		detail, err := common.AppendMetadata(
+			ctx, target, model.Provider.ValueString(), model.Key.ValueString(), model.Value.ValueString(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Entry{BranchID: keboola.BranchID(model.BranchID.ValueInt64()), Detail: detail}, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading storage metadata resource")
+
+	// Use the base resource abstraction for Read
+	r.base.ExecuteRead(ctx, req, resp, func(ctx context.Context, state Model) (*Entry, error) {
+		target, err := newTarget(r.metadata, state)
+		if err != nil {
+			return nil, err
+		}
+
+		details, err := target.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// A missing entry is removed from the state, so the next apply creates it again
+		detail := common.FindMetadata(details, state.Provider.ValueString(), state.Key.ValueString())
+		if detail == nil {
+			return nil, fmt.Errorf("metadata %q: %w", state.Key.ValueString(), abstraction.ErrResourceNotFound)
+		}
+
+		return &Entry{BranchID: keboola.BranchID(state.BranchID.ValueInt64()), Detail: detail}, nil
+	})
+}
+
+// Update updates the resource and sets the updated Terraform state.
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating storage metadata resource")
+
+	// Use the base resource abstraction for Update
+	r.base.ExecuteUpdate(ctx, req, resp, func(ctx context.Context, state, plan Model) (*Entry, error) {
+		// Preserve the branch from state
+		plan.BranchID = state.BranchID
+
+		target, err := newTarget(r.metadata, plan)
+		if err != nil {
+			return nil, err
+		}
+
+		detail, err := common.AppendMetadata(
+			ctx, target, plan.Provider.ValueString(), plan.Key.ValueString(), plan.Value.ValueString(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Appending under a new key or provider creates a new entry, the old one must be removed
+		if detail.ID != state.ID.ValueString() && state.ID.ValueString() != "" {
+			if err := target.Delete(ctx, state.ID.ValueString()); err != nil {
+				return nil, err
+			}
+		}
+
+		return &Entry{BranchID: keboola.BranchID(plan.BranchID.ValueInt64()), Detail: detail}, nil
+	})
+}
+
+// Delete deletes the resource and removes the Terraform state.
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting storage metadata resource")
+
+	// Use the generic base resource implementation
+	r.base.ExecuteDelete(ctx, req, resp, func(ctx context.Context, state Model) error {
+		target, err := newTarget(r.metadata, state)
+		if err != nil {
+			return err
+		}
+
+		return target.Delete(ctx, state.ID.ValueString())
+	})
+}
@@ -0,0 +1,143 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+)
+
+// newTarget creates the metadata target (bucket, table or column) described by the model.
+func newTarget(api apiclient.Metadata, model Model) (common.MetadataTarget, error) {
+	branchID := keboola.BranchID(model.BranchID.ValueInt64())
+
+	if model.TableID.ValueString() == "" {
+		bucketID, err := keboola.ParseBucketID(model.BucketID.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid bucket_id %q: %w", model.BucketID.ValueString(), err)
+		}
+
+		return &bucketTarget{
+			api: api,
+			key: keboola.BucketKey{BranchID: branchID, BucketID: bucketID},
+		}, nil
+	}
+
+	tableID, err := keboola.ParseTableID(model.TableID.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid table_id %q: %w", model.TableID.ValueString(), err)
+	}
+
+	key := keboola.TableKey{BranchID: branchID, TableID: tableID}
+	if model.ColumnName.ValueString() != "" {
+		return &columnTarget{api: api, key: key, column: model.ColumnName.ValueString()}, nil
+	}
+
+	return &tableTarget{api: api, key: key}, nil
+}
+
+// bucketTarget implements common.MetadataTarget for bucket metadata.
+type bucketTarget struct {
+	api apiclient.Metadata
+	key keboola.BucketKey
+}
+
+// Append appends metadata to the bucket.
+func (t *bucketTarget) Append(ctx context.Context, provider string, metadata keboola.Metadata) error {
+	if err := t.api.AppendBucketMetadata(ctx, t.key, provider, metadata); err != nil {
+		return fmt.Errorf("could not append bucket metadata: %w", err)
+	}
+
+	return nil
+}
+
+// List lists all metadata of the bucket.
+func (t *bucketTarget) List(ctx context.Context) (keboola.MetadataDetails, error) {
+	result, err := t.api.ListBucketMetadata(ctx, t.key)
+	if err != nil {
+		return nil, fmt.Errorf("could not list bucket metadata: %w", err)
+	}
+
+	return result, nil
+}
+
+// Delete deletes a single metadata entry of the bucket.
+func (t *bucketTarget) Delete(ctx context.Context, metadataID string) error {
+	if err := t.api.DeleteBucketMetadata(ctx, t.key, metadataID); err != nil {
+		return fmt.Errorf("could not delete bucket metadata: %w", err)
+	}
+
+	return nil
+}
+
+// tableTarget implements common.MetadataTarget for table metadata.
+type tableTarget struct {
+	api apiclient.Metadata
+	key keboola.TableKey
+}
+
+// Append appends metadata to the table.
+func (t *tableTarget) Append(ctx context.Context, provider string, metadata keboola.Metadata) error {
+	if err := t.api.AppendTableMetadata(ctx, t.key, provider, metadata); err != nil {
+		return fmt.Errorf("could not append table metadata: %w", err)
+	}
+
+	return nil
+}
+
+// List lists all metadata of the table.
+func (t *tableTarget) List(ctx context.Context) (keboola.MetadataDetails, error) {
+	result, err := t.api.ListTableMetadata(ctx, t.key)
+	if err != nil {
+		return nil, fmt.Errorf("could not list table metadata: %w", err)
+	}
+
+	return result, nil
+}
+
+// Delete deletes a single metadata entry of the table.
+func (t *tableTarget) Delete(ctx context.Context, metadataID string) error {
+	if err := t.api.DeleteTableMetadata(ctx, t.key, metadataID); err != nil {
+		return fmt.Errorf("could not delete table metadata: %w", err)
+	}
+
+	return nil
+}
+
+// columnTarget implements common.MetadataTarget for metadata of a single table column.
+type columnTarget struct {
+	api    apiclient.Metadata
+	key    keboola.TableKey
+	column string
+}
+
+// Append appends metadata to the column.
+func (t *columnTarget) Append(ctx context.Context, provider string, metadata keboola.Metadata) error {
+	if err := t.api.AppendColumnMetadata(ctx, t.key, t.column, provider, metadata); err != nil {
+		return fmt.Errorf("could not append column metadata: %w", err)
+	}
+
+	return nil
+}
+
+// List lists all metadata of the column.
+func (t *columnTarget) List(ctx context.Context) (keboola.MetadataDetails, error) {
+	result, err := t.api.ListColumnMetadata(ctx, t.key, t.column)
+	if err != nil {
+		return nil, fmt.Errorf("could not list column metadata: %w", err)
+	}
+
+	return result, nil
+}
+
+// Delete deletes a single metadata entry of the column.
+func (t *columnTarget) Delete(ctx context.Context, metadataID string) error {
+	if err := t.api.DeleteColumnMetadata(ctx, t.key, t.column, metadataID); err != nil {
+		return fmt.Errorf("could not delete column metadata: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// Static errors.
+var (
+	ErrInvalidBucketPermissions = errors.New("could not read bucket permissions")
+	ErrInvalidComponentAccess   = errors.New("could not read component access")
+)
+
+// Mapper implements ResourceMapper for storage token resources.
+type Mapper struct{}
+
+// MapAPIToTerraform converts a Keboola API keboola.Token to a Terraform model.
+func (m *Mapper) MapAPIToTerraform(
+	ctx context.Context,
+	apiModel *keboola.Token,
+	tfModel *Model,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfModel.ID = types.StringValue(apiModel.ID)
+	tfModel.Description = types.StringValue(apiModel.Description)
+	tfModel.CanManageBuckets = types.BoolValue(apiModel.CanManageBuckets)
+	tfModel.CanReadAllFileUploads = types.BoolValue(apiModel.CanReadAllFileUploads)
+	tfModel.Created = types.StringValue(apiModel.Created.UTC().String())
+
+	tfModel.Expires = types.StringNull()
+	if apiModel.Expires != nil {
+		tfModel.Expires = types.StringValue(apiModel.Expires.UTC().String())
+	}
+
+	// The token value is returned only on creation and refresh, keep the stored one otherwise
+	if apiModel.Token != "" {
+		tfModel.Token = types.StringValue(apiModel.Token)
+	}
+
+	permissions := make(map[string]string, len(apiModel.BucketPermissions))
+	for bucketID, permission := range apiModel.BucketPermissions {
+		permissions[bucketID.String()] = string(permission)
+	}
+	permissionsValue, mapDiags := types.MapValueFrom(ctx, types.StringType, permissions)
+	diags.Append(mapDiags...)
+	tfModel.BucketPermissions = permissionsValue
+
+	components := make([]string, 0, len(apiModel.ComponentAccess))
+	components = append(components, apiModel.ComponentAccess...)
+	sort.Strings(components)
+	componentsValue, setDiags := types.SetValueFrom(ctx, types.StringType, components)
+	diags.Append(setDiags...)
+	tfModel.ComponentAccess = componentsValue
+
+	return diags
+}
+
+// MapTerraformToAPI converts a Terraform storage token model to a Keboola API model.
+func (m *Mapper) MapTerraformToAPI(
+	ctx context.Context,
+	stateModel Model,
+	tfModel Model,
+) (*keboola.Token, error) {
+	token := &keboola.Token{
+		ID:                    stateModel.ID.ValueString(),
+		Description:           tfModel.Description.ValueString(),
+		CanManageBuckets:      tfModel.CanManageBuckets.ValueBool(),
+		CanReadAllFileUploads: tfModel.CanReadAllFileUploads.ValueBool(),
+		BucketPermissions:     keboola.BucketPermissions{},
+	}
+
+	permissions := make(map[string]string)
+	if diags := tfModel.BucketPermissions.ElementsAs(ctx, &permissions, false); diags.HasError() {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBucketPermissions, diags)
+	}
+	for bucketID, permission := range permissions {
+		id, err := keboola.ParseBucketID(bucketID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bucket ID %q: %w", bucketID, err)
+		}
+		token.BucketPermissions[id] = keboola.BucketPermission(permission)
+	}
+
+	if diags := tfModel.ComponentAccess.ElementsAs(ctx, &token.ComponentAccess, false); diags.HasError() {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidComponentAccess, diags)
+	}
+
+	return token, nil
+}
+
+// ValidateTerraformModel validates a Terraform storage token model.
+func (m *Mapper) ValidateTerraformModel(
+	ctx context.Context,
+	_ *Model,
+	newModel *Model,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only read and write permissions are supported by the API
+	if !newModel.BucketPermissions.IsNull() && !newModel.BucketPermissions.IsUnknown() {
+		permissions := make(map[string]string)
+		diags.Append(newModel.BucketPermissions.ElementsAs(ctx, &permissions, false)...)
+		for bucketID, permission := range permissions {
+			if _, err := keboola.ParseBucketID(bucketID); err != nil {
+				diags.AddAttributeError(
+					path.Root("bucket_permissions").AtMapKey(bucketID),
+					"Invalid Bucket ID",
+					fmt.Sprintf("Bucket ID '%s' is not valid: %s", bucketID, err.Error()),
+				)
+			}
+
+			if permission != string(keboola.BucketPermissionRead) && permission != string(keboola.BucketPermissionWrite) {
+				diags.AddAttributeError(
+					path.Root("bucket_permissions").AtMapKey(bucketID),
+					"Invalid Bucket Permission",
+					fmt.Sprintf("Permission '%s' is not valid, expected 'read' or 'write'", permission),
+				)
+			}
+		}
+	}
+
+	// Expiration must be in the future
+	if !newModel.ExpiresIn.IsNull() && !newModel.ExpiresIn.IsUnknown() && newModel.ExpiresIn.ValueInt64() <= 0 {
+		diags.AddAttributeError(
+			path.Root("expires_in"),
+			"Invalid Expiration",
+			"expires_in must be a positive number of seconds",
+		)
+	}
+
+	return diags
+}
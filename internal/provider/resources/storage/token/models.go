@@ -0,0 +1,23 @@
+package token
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model defines the storage token resource model.
+type Model struct {
+	ID                    types.String   `tfsdk:"id"`
+	Description           types.String   `tfsdk:"description"`
+	BucketPermissions     types.Map      `tfsdk:"bucket_permissions"`
+	ComponentAccess       types.Set      `tfsdk:"component_access"`
+	CanManageBuckets      types.Bool     `tfsdk:"can_manage_buckets"`
+	CanReadAllFileUploads types.Bool     `tfsdk:"can_read_all_file_uploads"`
+	ExpiresIn             types.Int64    `tfsdk:"expires_in"`
+	Expires               types.String   `tfsdk:"expires"`
+	Created               types.String   `tfsdk:"created"`
+	Rotation              types.String   `tfsdk:"rotation"`
+	Token                 types.String   `tfsdk:"token"`
+	DeletionProtection    types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,312 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.Token]{}, client: nil,
+	}
+	_ resource.ResourceWithConfigure = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.Token]{}, client: nil,
+	}
+	_ resource.ResourceWithModifyPlan = &Resource{
+		base: abstraction.BaseResource[Model, *keboola.Token]{}, client: nil,
+	}
+)
+
+// Resource is the storage token resource implementation.
+type Resource struct {
+	// Base functionality with token model specifics
+	base abstraction.BaseResource[Model, *keboola.Token]
+
+	// Direct access to the API client for specific operations
+	client *keboola.AuthorizedAPI
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() *Resource {
+	return &Resource{}
+}
+
+// Metadata returns the resource type name.
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Permissions of an existing token cannot be changed, any change creates a new token
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server
+		MarkdownDescription: "Manages a Storage API token (https://keboola.docs.apiary.io/#reference/tokens-and-permissions).", //nolint: lll
+		Description:         "Manages a Storage API token (https://keboola.docs.apiary.io/#reference/tokens-and-permissions).", //nolint: lll
+		DeprecationMessage:  "",
+		Version:             1,
+		Blocks: map[string]schema.Block{
+			"timeouts": abstraction.TimeoutsBlock(ctx),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"deletion_protection": abstraction.DeletionProtectionAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Token ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Token description",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bucket_permissions": schema.MapAttribute{
+				MarkdownDescription: "Map of bucket IDs to permissions, either `read` or `write`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"component_access": schema.SetAttribute{
+				MarkdownDescription: "IDs of the components the token can access.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"can_manage_buckets": schema.BoolAttribute{
+				MarkdownDescription: "Whether the token can create, modify and delete buckets.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"can_read_all_file_uploads": schema.BoolAttribute{
+				MarkdownDescription: "Whether the token can read all file uploads of the project.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "Token lifetime in seconds. If not specified, then the token never expires.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the token expiration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the token creation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value, the token is refreshed whenever it changes, e.g. `time_rotating.token.id`.",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token value",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// Return silently if provider data is not available (yet)
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Get the provider data - ignoring the type assertion success
+	providerData, _ := req.ProviderData.(*providermodels.ProviderData)
+
+	// Set up the API client
+	r.client = providerData.Client
+
+	// Refuse mutating operations in read-only mode
+	r.base.ReadOnly = providerData.ReadOnly
+
+	// Set up the mapper
+	r.base.Mapper = &Mapper{}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating storage token resource")
+
+	// Use the base resource abstraction for Create
+	r.base.ExecuteCreate(ctx, req, resp, func(ctx context.Context, model Model) (*keboola.Token, error) {
+		// Map the Terraform model to the API model
+		apiModel, err := r.base.Mapper.MapTerraformToAPI(ctx, Model{}, model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map Terraform model to API: %w", err)
+		}
+
+		opts := []keboola.CreateTokenOption{
+			keboola.WithDescription(apiModel.Description),
+			keboola.WithCanManageBuckets(apiModel.CanManageBuckets),
+			keboola.WithCanReadAllFileUploads(apiModel.CanReadAllFileUploads),
+			keboola.WithBucketPermissions(apiModel.BucketPermissions),
+		}
+		if len(apiModel.ComponentAccess) > 0 {
+			components := make([]keboola.ComponentID, 0, len(apiModel.ComponentAccess))
+			for _, component := range apiModel.ComponentAccess {
+				components = append(components, keboola.ComponentID(component))
+			}
+			opts = append(opts, keboola.WithComponentAccess(components...))
+		}
+		if !model.ExpiresIn.IsNull() && !model.ExpiresIn.IsUnknown() {
+			opts = append(opts, keboola.WithExpiresIn(time.Duration(model.ExpiresIn.ValueInt64())*time.Second))
+		}
+
+		// Call the API to create the token
+		result, err := r.client.CreateTokenRequest(opts...).Send(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create token: %w", err)
+		}
+
+		return result, nil
+	})
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading storage token resource")
+
+	// Use the base resource abstraction for Read
+	r.base.ExecuteRead(ctx, req, resp, func(ctx context.Context, state Model) (*keboola.Token, error) {
+		return r.findToken(ctx, state.ID.ValueString())
+	})
+}
+
+// Update updates the resource and sets the updated Terraform state.
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating storage token resource")
+
+	// Use the base resource abstraction for Update
+	r.base.ExecuteUpdate(ctx, req, resp, func(ctx context.Context, state, plan Model) (*keboola.Token, error) {
+		// All other attributes require replacement, only the rotation trigger and deletion_protection are updated in place
+		if plan.Rotation.Equal(state.Rotation) {
+			result, err := r.findToken(ctx, state.ID.ValueString())
+			if err != nil {
+				return nil, err
+			}
+
+			// The token value is not listed, keep the stored one
+			unchanged := *result
+			unchanged.Token = state.Token.ValueString()
+
+			return &unchanged, nil
+		}
+
+		tflog.Info(ctx, "Rotation changed, refreshing token", map[string]any{
+			"token_id": state.ID.ValueString(),
+		})
+
+		result, err := r.client.RefreshTokenRequest(state.ID.ValueString()).Send(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh token: %w", err)
+		}
+
+		return result, nil
+	})
+}
+
+// ModifyPlan plans a new token value when the rotation trigger changes, the stored value is kept otherwise.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Rotation.Equal(state.Rotation) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringUnknown())...)
+}
+
+// Delete deletes the resource and removes the Terraform state.
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting storage token resource")
+
+	// Use the generic base resource implementation
+	r.base.ExecuteDelete(ctx, req, resp, func(ctx context.Context, state Model) error {
+		err := r.client.DeleteTokenRequest(state.ID.ValueString()).SendOrErr(ctx)
+		if err != nil {
+			return fmt.Errorf("could not delete token: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// findToken finds the token by ID in the list of project tokens.
+func (r *Resource) findToken(ctx context.Context, tokenID string) (*keboola.Token, error) {
+	tokens, err := r.client.ListTokensRequest().Send(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list tokens: %w", err)
+	}
+
+	for _, token := range *tokens {
+		if token.ID == tokenID {
+			return token, nil
+		}
+	}
+
+	// Expired tokens are removed by the API
+	return nil, fmt.Errorf("token %s: %w", tokenID, abstraction.ErrResourceNotFound)
+}
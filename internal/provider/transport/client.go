@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/client"
+)
+
+// Config configures the HTTP client used by the Keboola API client.
+type Config struct {
+	Retry RetryConfig
+	// RateLimiter is optional, it is shared by all clients created with it.
+	RateLimiter *RateLimiter
+	// Transport sends the requests, http.DefaultTransport is used if it is nil.
+	Transport http.RoundTripper
+}
+
+// NewClient creates the HTTP client for keboola.NewAuthorizedAPI.
+func NewClient(config Config) client.Client {
+	roundTripper := http.DefaultTransport
+	if config.Transport != nil {
+		roundTripper = config.Transport
+	}
+	if config.RateLimiter != nil {
+		// Each retry attempt is limited too, so it is wrapped by the retry transport
+		roundTripper = NewRateLimitTransport(roundTripper, config.RateLimiter)
+	}
+	roundTripper = NewRetryTransport(roundTripper, config.Retry)
+
+	// Retries are handled by RetryTransport, the SDK's own retries would multiply the attempts
+	return client.New().
+		WithTransport(roundTripper).
+		WithRetry(client.RetryConfig{Count: 0}) //nolint: exhaustruct
+}
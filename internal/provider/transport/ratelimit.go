@@ -0,0 +1,113 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig configures the RateLimiter, zero values disable the particular limit.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the maximum rate of requests sent to the API, it can be lower than one.
+	RequestsPerSecond float64
+	// MaxConcurrent is the maximum number of requests in flight at the same time.
+	MaxConcurrent int
+}
+
+// RateLimiter limits the rate and the concurrency of the API requests.
+// A single instance is shared by all resources and data sources of the provider.
+type RateLimiter struct {
+	// limiter is nil when the rate is not limited
+	limiter *rate.Limiter
+	// slots is nil when the concurrency is not limited
+	slots chan struct{}
+}
+
+// NewRateLimiter creates a limiter with the given settings.
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	l := &RateLimiter{}
+
+	if config.RequestsPerSecond > 0 {
+		// The burst equals the rate per one second, at least one request is always allowed
+		burst := max(1, int(math.Floor(config.RequestsPerSecond)))
+		l.limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), burst)
+	}
+
+	if config.MaxConcurrent > 0 {
+		l.slots = make(chan struct{}, config.MaxConcurrent)
+	}
+
+	return l
+}
+
+// Acquire waits until a request can be sent. The returned function must be called when the request is done.
+func (l *RateLimiter) Acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			var once sync.Once
+			release = func() { once.Do(func() { <-l.slots }) }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			release()
+
+			return nil, err //nolint: wrapcheck
+		}
+	}
+
+	return release, nil
+}
+
+// RateLimitTransport sends each request, including each retry attempt, through the RateLimiter.
+// The concurrency slot is held until the response body is closed.
+type RateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *RateLimiter
+}
+
+// NewRateLimitTransport wraps the next round tripper with the limiter.
+func NewRateLimitTransport(next http.RoundTripper, limiter *RateLimiter) *RateLimitTransport {
+	return &RateLimitTransport{next: next, limiter: limiter}
+}
+
+// RoundTrip waits for the limiter and executes the request.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+
+		return nil, err //nolint: wrapcheck
+	}
+
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// releaseOnClose releases the concurrency slot when the response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	defer b.release()
+
+	return b.ReadCloser.Close() //nolint: wrapcheck
+}
@@ -0,0 +1,196 @@
+// Package transport contains HTTP round trippers wrapping the Keboola API client.
+package transport
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry settings used when the provider configuration does not override them.
+const (
+	DefaultMaxAttempts = 5
+	DefaultMaxWait     = 30 * time.Second
+	DefaultInitialWait = 500 * time.Millisecond
+)
+
+// RetryConfig configures the RetryTransport.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts including the first one, 1 disables retries.
+	MaxAttempts int
+	// InitialWait is the wait before the first retry, it doubles with each further retry.
+	InitialWait time.Duration
+	// MaxWait caps a single wait, including the wait requested by the Retry-After header.
+	MaxWait time.Duration
+}
+
+// DefaultRetryConfig returns the default retry settings.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: DefaultMaxAttempts,
+		InitialWait: DefaultInitialWait,
+		MaxWait:     DefaultMaxWait,
+	}
+}
+
+// RetryTransport retries requests failed due to transient API errors with jittered exponential backoff.
+//
+// Idempotent requests are retried on network errors and on 429, 502, 503 and 504 responses.
+// Other requests are retried only on 429 and 503 responses, which are returned before the request is processed.
+// These are POST creating a configuration, a retry could create a duplicate,
+// and DELETE, the first one moves a configuration to trash and a repeated one purges it.
+type RetryTransport struct {
+	next   http.RoundTripper
+	config RetryConfig
+}
+
+// NewRetryTransport wraps the next round tripper with retries.
+func NewRetryTransport(next http.RoundTripper, config RetryConfig) *RetryTransport {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+
+	return &RetryTransport{next: next, config: config}
+}
+
+// RoundTrip executes the request, retrying it on transient failures.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+
+		if attempt >= t.config.MaxAttempts || !t.shouldRetry(req, resp, err) {
+			return resp, err //nolint: wrapcheck
+		}
+
+		// The body of the request must be rewound before it is sent again
+		retryReq, ok := rewind(req)
+		if !ok {
+			return resp, err //nolint: wrapcheck
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		// Release the connection of the failed attempt
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		req = retryReq
+	}
+}
+
+// shouldRetry decides whether the failed attempt can be retried safely.
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// Do not retry when the caller gave up
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := isIdempotent(req.Method)
+
+	// Network errors: a non-idempotent request may have been processed already
+	if err != nil {
+		return idempotent
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait before the next attempt.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	// Retry-After header takes precedence
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.config.MaxWait)
+		}
+	}
+
+	// Exponential backoff with jitter, in the range <wait/2, wait>
+	wait := t.config.InitialWait
+	for i := 1; i < attempt && wait < t.config.MaxWait; i++ {
+		wait *= 2
+	}
+	wait = min(wait, t.config.MaxWait)
+	if wait <= 0 {
+		return 0
+	}
+
+	half := wait / 2
+
+	return half + time.Duration(rand.Int64N(int64(half)+1)) //nolint: gosec
+}
+
+// parseRetryAfter parses the Retry-After header, which contains either seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// rewind returns a copy of the request with a fresh body.
+func rewind(req *http.Request) (*http.Request, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, true
+	}
+
+	if req.GetBody == nil {
+		return nil, false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = body
+
+	return clone, true
+}
+
+// sleep waits for the duration or until the context is done.
+func sleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isIdempotent returns true if a repeated request has the same effect as a single one.
+// DELETE is not, a repeated delete of a configuration purges it from trash.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut:
+		return true
+	default:
+		return false
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
 )
 
 // the provider and resources without creating circular dependencies.
@@ -13,6 +15,18 @@ type ProviderData struct {
 	Client     *keboola.AuthorizedAPI
 	Token      *keboola.Token
 	Components []*keboola.Component
+
+	// Per-domain API clients used by the resources, they wrap the Client.
+	// Tests can replace them with in-memory doubles.
+	Configs    apiclient.Configs
+	Branches   apiclient.Branches
+	Metadata   apiclient.Metadata
+	Encryption apiclient.Encryption
+	Schedules  apiclient.Schedules
+	Jobs       apiclient.Jobs
+
+	// ReadOnly is set when the provider must not call any mutating API.
+	ReadOnly bool
 }
 
 // GetClient returns the keboola API client.
@@ -0,0 +1,118 @@
+package abstraction_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+)
+
+func testQueuePolling() abstraction.QueueJobPolling {
+	return abstraction.QueueJobPolling{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+}
+
+func testQueueJob(status string) *keboola.QueueJob {
+	job := &keboola.QueueJob{}
+	job.ID = "456"
+	job.Status = status
+
+	return job
+}
+
+// queueJobSequence returns a getter returning jobs with the given statuses, one per call.
+func queueJobSequence(statuses ...string) (abstraction.QueueJobGetter, *int) {
+	calls := 0
+	getter := func(_ context.Context, _ keboola.JobKey) (*keboola.QueueJob, error) {
+		job := testQueueJob(statuses[min(calls, len(statuses)-1)])
+		calls++
+
+		return job, nil
+	}
+
+	return getter, &calls
+}
+
+func TestPollQueueJobSuccess(t *testing.T) {
+	t.Parallel()
+
+	getJob, calls := queueJobSequence(
+		abstraction.QueueJobStatusWaiting, abstraction.QueueJobStatusProcessing, abstraction.QueueJobStatusWarning,
+	)
+	job, err := abstraction.PollQueueJob(
+		t.Context(), getJob, testQueueJob(abstraction.QueueJobStatusCreated), testQueuePolling(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, abstraction.QueueJobStatusWarning, job.Status)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestPollQueueJobFinishedJobIsNotPolled(t *testing.T) {
+	t.Parallel()
+
+	getJob, calls := queueJobSequence(abstraction.QueueJobStatusError)
+	job, err := abstraction.PollQueueJob(
+		t.Context(), getJob, testQueueJob(abstraction.QueueJobStatusSuccess), testQueuePolling(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, abstraction.QueueJobStatusSuccess, job.Status)
+	assert.Equal(t, 0, *calls)
+}
+
+func TestPollQueueJobFailure(t *testing.T) {
+	t.Parallel()
+
+	getJob := func(_ context.Context, _ keboola.JobKey) (*keboola.QueueJob, error) {
+		job := testQueueJob(abstraction.QueueJobStatusError)
+		job.IsFinished = true
+		job.Result.Message = "Invalid credentials."
+
+		return job, nil
+	}
+
+	job, err := abstraction.PollQueueJob(
+		t.Context(), getJob, testQueueJob(abstraction.QueueJobStatusCreated), testQueuePolling(),
+	)
+
+	// The failed job is returned with the error, so it can be stored
+	var jobErr *abstraction.QueueJobError
+	require.ErrorAs(t, err, &jobErr)
+	require.NotNil(t, job)
+	assert.Equal(t, abstraction.QueueJobStatusError, job.Status)
+	assert.Equal(t, keboola.JobID("456"), jobErr.JobID)
+	assert.Equal(t, "job 456 finished with status error: Invalid credentials.", err.Error())
+}
+
+func TestPollQueueJobRespectsDeadline(t *testing.T) {
+	t.Parallel()
+
+	getJob, _ := queueJobSequence(abstraction.QueueJobStatusProcessing)
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	job, err := abstraction.PollQueueJob(
+		ctx, getJob, testQueueJob(abstraction.QueueJobStatusCreated), testQueuePolling(),
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, job)
+	assert.Contains(t, err.Error(), "job 456 did not finish")
+}
+
+func TestPollQueueJobGetError(t *testing.T) {
+	t.Parallel()
+
+	errAPI := errors.New("service unavailable")
+	getJob := func(_ context.Context, _ keboola.JobKey) (*keboola.QueueJob, error) {
+		return nil, errAPI
+	}
+
+	_, err := abstraction.PollQueueJob(
+		t.Context(), getJob, testQueueJob(abstraction.QueueJobStatusCreated), testQueuePolling(),
+	)
+	require.ErrorIs(t, err, errAPI)
+}
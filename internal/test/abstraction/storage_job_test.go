@@ -0,0 +1,111 @@
+package abstraction_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+)
+
+func testPolling() abstraction.StorageJobPolling {
+	return abstraction.StorageJobPolling{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+}
+
+func testJob(status string) *keboola.StorageJob {
+	job := &keboola.StorageJob{}
+	job.ID = 123
+	job.OperationName = "devBranchCreate"
+	job.Status = status
+
+	return job
+}
+
+// jobSequence returns a getter returning jobs with the given statuses, one per call.
+func jobSequence(statuses ...string) (abstraction.StorageJobGetter, *int) {
+	calls := 0
+	getter := func(_ context.Context, _ keboola.StorageJobKey) (*keboola.StorageJob, error) {
+		job := testJob(statuses[min(calls, len(statuses)-1)])
+		calls++
+
+		return job, nil
+	}
+
+	return getter, &calls
+}
+
+func TestPollStorageJobSuccess(t *testing.T) {
+	t.Parallel()
+
+	getJob, calls := jobSequence(abstraction.StorageJobStatusProcessing, abstraction.StorageJobStatusSuccess)
+	job, err := abstraction.PollStorageJob(
+		t.Context(), getJob, testJob(abstraction.StorageJobStatusWaiting), testPolling(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, abstraction.StorageJobStatusSuccess, job.Status)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestPollStorageJobFinishedJobIsNotPolled(t *testing.T) {
+	t.Parallel()
+
+	getJob, calls := jobSequence(abstraction.StorageJobStatusError)
+	job, err := abstraction.PollStorageJob(
+		t.Context(), getJob, testJob(abstraction.StorageJobStatusSuccess), testPolling(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, abstraction.StorageJobStatusSuccess, job.Status)
+	assert.Equal(t, 0, *calls)
+}
+
+func TestPollStorageJobFailure(t *testing.T) {
+	t.Parallel()
+
+	getJob := func(_ context.Context, _ keboola.StorageJobKey) (*keboola.StorageJob, error) {
+		job := testJob(abstraction.StorageJobStatusError)
+		job.Error.Message = "Branch with name \"dev\" already exists."
+		job.Error.ExceptionID = "exception-1"
+
+		return job, nil
+	}
+
+	_, err := abstraction.PollStorageJob(t.Context(), getJob, testJob(abstraction.StorageJobStatusWaiting), testPolling())
+
+	var jobErr *abstraction.StorageJobError
+	require.ErrorAs(t, err, &jobErr)
+	assert.Equal(t, keboola.StorageJobID(123), jobErr.JobID)
+	assert.Equal(
+		t,
+		`storage job 123 (devBranchCreate) failed: Branch with name "dev" already exists. (exception ID: exception-1)`,
+		err.Error(),
+	)
+}
+
+func TestPollStorageJobRespectsDeadline(t *testing.T) {
+	t.Parallel()
+
+	getJob, _ := jobSequence(abstraction.StorageJobStatusProcessing)
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := abstraction.PollStorageJob(ctx, getJob, testJob(abstraction.StorageJobStatusWaiting), testPolling())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "storage job 123 (devBranchCreate) did not finish")
+}
+
+func TestPollStorageJobGetError(t *testing.T) {
+	t.Parallel()
+
+	errAPI := errors.New("service unavailable")
+	getJob := func(_ context.Context, _ keboola.StorageJobKey) (*keboola.StorageJob, error) {
+		return nil, errAPI
+	}
+
+	_, err := abstraction.PollStorageJob(t.Context(), getJob, testJob(abstraction.StorageJobStatusWaiting), testPolling())
+	require.ErrorIs(t, err, errAPI)
+}
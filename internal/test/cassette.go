@@ -0,0 +1,71 @@
+package test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/transport"
+	"github.com/keboola/terraform-provider-keboola/internal/test/cassette"
+)
+
+// The host and the token used when the cassettes are replayed, the recorded requests are matched without the host.
+const (
+	replayHost  = "https://connection.keboola.test"
+	replayToken = "replay-token" //nolint: gosec
+)
+
+// recorders contains the cassette recorder of each test, shared by the provider and the helpers of the test.
+var recorders sync.Map //nolint: gochecknoglobals
+
+// replaysCassettes returns true if TEST_KBC_CASSETTE is set to "replay".
+func replaysCassettes() bool {
+	mode, _ := cassette.ModeFromEnv()
+
+	return mode == cassette.ModeReplay
+}
+
+// Transport returns the round tripper recording or replaying the API requests of the test,
+// according to the TEST_KBC_CASSETTE environment variable, see the cassette package.
+// It returns nil if the cassettes are not used.
+func Transport(t *testing.T) http.RoundTripper {
+	t.Helper()
+
+	mode, err := cassette.ModeFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mode == cassette.ModeOff {
+		return nil
+	}
+
+	if recorder, ok := recorders.Load(t.Name()); ok {
+		return recorder.(*cassette.Recorder) //nolint: forcetypeassert
+	}
+
+	recorder := cassette.NewRecorder(t, mode, http.DefaultTransport)
+	recorders.Store(t.Name(), recorder)
+	t.Cleanup(func() { recorders.Delete(t.Name()) })
+
+	return recorder
+}
+
+// APIClient returns a Keboola API client for the checks of the test, its requests are recorded with the test.
+func APIClient(t *testing.T) *keboola.AuthorizedAPI {
+	t.Helper()
+
+	httpClient := transport.NewClient(transport.Config{
+		Retry:     transport.DefaultRetryConfig(),
+		Transport: Transport(t),
+	})
+
+	client, err := keboola.NewAuthorizedAPI(t.Context(), Host(), Token(), keboola.WithClient(&httpClient))
+	if err != nil {
+		t.Fatalf("could not initialize Keboola client: %s", err)
+	}
+
+	return client
+}
@@ -0,0 +1,249 @@
+// Package cassette records the HTTP interactions of acceptance tests and replays them without network access.
+//
+// Cassettes are stored next to the tests in testdata/cassettes/<TestName>.json.
+// Tokens and encrypted values are scrubbed before a cassette is saved, so it can be committed.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Mode selects whether the HTTP interactions are recorded or replayed.
+type Mode string
+
+const (
+	// ModeOff sends the requests without recording them.
+	ModeOff Mode = ""
+	// ModeRecord sends the requests and saves the interactions to the cassette at the end of the test.
+	ModeRecord Mode = "record"
+	// ModeReplay answers the requests from the cassette, no request leaves the process.
+	ModeReplay Mode = "replay"
+
+	// ModeEnv is the environment variable selecting the mode.
+	ModeEnv = "TEST_KBC_CASSETTE"
+
+	// Dir is the directory of the cassettes, relative to the package of the test.
+	Dir = "testdata/cassettes"
+)
+
+// ErrNoInteraction is returned in the replay mode for a request not found in the cassette.
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+// Cassette contains the recorded interactions of a test.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	replayed bool
+}
+
+// Request is a recorded request, it is matched by the method, the path and the query.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode  int    `json:"statusCode"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// ModeFromEnv returns the mode selected by the TEST_KBC_CASSETTE environment variable.
+func ModeFromEnv() (Mode, error) {
+	switch mode := Mode(os.Getenv(ModeEnv)); mode { //nolint: forbidigo
+	case ModeOff, ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return ModeOff, fmt.Errorf(`invalid %s "%s", expected "%s" or "%s"`, ModeEnv, mode, ModeRecord, ModeReplay)
+	}
+}
+
+// Path returns the path of the cassette of the test.
+func Path(t *testing.T) string {
+	t.Helper()
+
+	return filepath.Join(Dir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// Recorder is an http.RoundTripper recording or replaying the interactions of a test.
+type Recorder struct {
+	mode     Mode
+	path     string
+	next     http.RoundTripper
+	lock     sync.Mutex
+	cassette *Cassette
+}
+
+// NewRecorder creates a recorder of the test in the mode.
+// In the record mode, the requests are sent by the next round tripper and the cassette is saved when the test ends.
+// In the replay mode, the test is skipped if it has no cassette.
+func NewRecorder(t *testing.T, mode Mode, next http.RoundTripper) *Recorder {
+	t.Helper()
+
+	r := &Recorder{mode: mode, path: Path(t), next: next, cassette: &Cassette{Interactions: []*Interaction{}}}
+
+	switch mode {
+	case ModeRecord:
+		t.Cleanup(func() {
+			if err := r.Save(); err != nil {
+				t.Errorf("cannot save cassette: %s", err)
+			}
+		})
+	case ModeReplay:
+		data, err := os.ReadFile(r.path)
+		if errors.Is(err, os.ErrNotExist) {
+			t.Skipf("no cassette %s recorded, run the test with %s=%s first", r.path, ModeEnv, ModeRecord)
+		}
+		if err != nil {
+			t.Fatalf("cannot read cassette: %s", err)
+		}
+		if err := json.Unmarshal(data, r.cassette); err != nil {
+			t.Fatalf("cannot parse cassette %s: %s", r.path, err)
+		}
+	case ModeOff:
+	}
+
+	return r
+}
+
+// RoundTrip records or replays the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeReplay {
+		return r.replay(req)
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("cannot read request body: %w", err)
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil || r.mode != ModeRecord {
+		return resp, err //nolint: wrapcheck
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    Scrub(req.URL.String()),
+			Body:   ScrubBody(req.Header.Get("Content-Type"), reqBody),
+		},
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        ScrubBody(resp.Header.Get("Content-Type"), respBody),
+		},
+	})
+
+	return resp, nil
+}
+
+// Save writes the recorded interactions to the cassette.
+func (r *Recorder) Save() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("cannot create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil { //nolint: gosec
+		return fmt.Errorf("cannot write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// replay returns the response of the first not yet replayed interaction with the same method, path and query.
+// The host is ignored, so the tests can be replayed without the recorded stack.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	requestURI := Scrub(req.URL.RequestURI())
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.replayed || interaction.Request.Method != req.Method {
+			continue
+		}
+
+		if requestURI != requestURIOf(interaction.Request.URL) {
+			continue
+		}
+
+		interaction.replayed = true
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+
+		header := http.Header{}
+		if interaction.Response.ContentType != "" {
+			header.Set("Content-Type", interaction.Response.ContentType)
+		}
+
+		return &http.Response{
+			Status:        http.StatusText(interaction.Response.StatusCode),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w for %s %s in %s", ErrNoInteraction, req.Method, requestURI, r.path)
+}
+
+func requestURIOf(rawURL string) string {
+	// Strip the scheme and the host, keep the path and the query
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		rawURL = rawURL[i+3:]
+		if j := strings.Index(rawURL, "/"); j >= 0 {
+			return rawURL[j:]
+		}
+
+		return "/"
+	}
+
+	return rawURL
+}
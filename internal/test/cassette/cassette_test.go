@@ -0,0 +1,149 @@
+package cassette_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/test/cassette"
+)
+
+func TestScrub(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "token",
+			input:    `{"id":"123","token":"1234-56789-abcdefghijklmnopqrstuvwxyz"}`,
+			expected: `{"id":"123","token":"SCRUBBED"}`,
+		},
+		{
+			name:     "token in text",
+			input:    `invalid token 1234-56789-abcdefghijklmnopqrstuvwxyz`,
+			expected: `invalid token SCRUBBED`,
+		},
+		{
+			name:     "encrypted value",
+			input:    `{"#value":"KBC::ProjectSecure::eJwBYAGf/jhg+aBc=="}`,
+			expected: `{"#value":"KBC::ProjectSecure::SCRUBBED"}`,
+		},
+		{
+			name:     "secret value",
+			input:    `{"#password":"plain secret","user":"admin"}`,
+			expected: `{"#password":"SCRUBBED","user":"admin"}`,
+		},
+		{
+			name:     "secret value in a JSON string",
+			input:    `{"configuration":"{\"#password\":\"plain secret\",\"user\":\"admin\"}"}`,
+			expected: `{"configuration":"{\"#password\":\"SCRUBBED\",\"user\":\"admin\"}"}`,
+		},
+		{
+			name:     "empty secret value",
+			input:    `{"#password":""}`,
+			expected: `{"#password":""}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, cassette.Scrub(tc.input))
+		})
+	}
+
+	form := cassette.ScrubBody(
+		"application/x-www-form-urlencoded",
+		[]byte(`name=test&configuration=%7B%22%23password%22%3A%22secret%22%7D`),
+	)
+	assert.Equal(t, `configuration=%7B%22%23password%22%3A%22SCRUBBED%22%7D&name=test`, form)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/storage/tokens/verify":
+			_, _ = w.Write([]byte(`{"id":"1","token":"1234-56789-abcdefghijklmnopqrstuvwxyz"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+		}
+	}))
+
+	send := func(t *testing.T, recorder http.RoundTripper, path string) (int, string) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+
+		resp, err := recorder.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp.StatusCode, string(body)
+	}
+
+	t.Run("record", func(t *testing.T) {
+		recorder := cassette.NewRecorder(t, cassette.ModeRecord, http.DefaultTransport)
+
+		// The test gets the real response
+		status, body := send(t, recorder, "/v2/storage/tokens/verify")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Contains(t, body, "1234-56789-abcdefghijklmnopqrstuvwxyz")
+
+		status, _ = send(t, recorder, "/v2/storage/unknown")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	// The cassette is saved at the end of the test, without the secrets
+	data, err := os.ReadFile(filepath.Join(cassette.Dir, "TestRecordAndReplay_record.json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "abcdefghijklmnopqrstuvwxyz")
+	assert.Contains(t, string(data), cassette.Scrubbed)
+
+	require.NoError(t, os.Rename(
+		filepath.Join(cassette.Dir, "TestRecordAndReplay_record.json"),
+		filepath.Join(cassette.Dir, "TestRecordAndReplay_replay.json"),
+	))
+	server.Close()
+
+	t.Run("replay", func(t *testing.T) {
+		recorder := cassette.NewRecorder(t, cassette.ModeReplay, nil)
+
+		// The server is closed, the responses come from the cassette
+		status, body := send(t, recorder, "/v2/storage/tokens/verify")
+		assert.Equal(t, http.StatusOK, status)
+		assert.JSONEq(t, `{"id":"1","token":"SCRUBBED"}`, body)
+
+		status, _ = send(t, recorder, "/v2/storage/unknown")
+		assert.Equal(t, http.StatusNotFound, status)
+
+		// Each interaction is replayed once
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/v2/storage/unknown", nil)
+		require.NoError(t, err)
+		_, err = recorder.RoundTrip(req) //nolint: bodyclose
+		require.ErrorIs(t, err, cassette.ErrNoInteraction)
+		assert.True(t, strings.Contains(err.Error(), "GET /v2/storage/unknown"))
+	})
+
+	t.Run("missing cassette", func(t *testing.T) {
+		cassette.NewRecorder(t, cassette.ModeReplay, nil)
+		t.Error("the test must be skipped")
+	})
+}
@@ -0,0 +1,58 @@
+package cassette
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Scrubbed replaces the secrets in the cassettes.
+const Scrubbed = "SCRUBBED"
+
+var (
+	// encryptedValueRegexp matches values encrypted by the Encryption API, e.g. KBC::ProjectSecure::<cipher>.
+	// The prefix is kept, so the tests can still check the value is encrypted.
+	encryptedValueRegexp = regexp.MustCompile(`(KBC::[A-Za-z]+::)[A-Za-z0-9+/=_-]+`)
+	// tokenRegexp matches Storage API tokens, e.g. 123-45678-<secret>.
+	tokenRegexp = regexp.MustCompile(`\b\d+-\d+-[A-Za-z0-9]{20,}\b`)
+	// secretJSONRegexp matches the string values of the "token" key and of the keys starting with "#",
+	// also in a JSON document encoded in a JSON string, e.g. the configuration content.
+	secretJSONRegexp = regexp.MustCompile(`(\\*"(?:#[^"\\]*|token)\\*"\s*:\s*\\*")((?:[^"\\]|\\[^"])*)(\\*")`)
+)
+
+// Scrub replaces tokens, secret values and encrypted values in the text.
+func Scrub(text string) string {
+	text = secretJSONRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		parts := secretJSONRegexp.FindStringSubmatch(match)
+		if parts[2] == "" || strings.HasPrefix(parts[2], "KBC::") {
+			return match
+		}
+
+		return parts[1] + Scrubbed + parts[3]
+	})
+	text = encryptedValueRegexp.ReplaceAllString(text, "${1}"+Scrubbed)
+	text = tokenRegexp.ReplaceAllString(text, Scrubbed)
+
+	return text
+}
+
+// ScrubBody scrubs a request or response body, form values are scrubbed one by one.
+func ScrubBody(contentType string, body []byte) string {
+	if !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return Scrub(string(body))
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return Scrub(string(body))
+	}
+
+	for key, items := range values {
+		for i, item := range items {
+			items[i] = Scrub(item)
+		}
+		values[key] = items
+	}
+
+	return values.Encode()
+}
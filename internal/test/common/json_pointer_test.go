@@ -0,0 +1,109 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+)
+
+func parseJSON(t *testing.T, value string) *orderedmap.OrderedMap {
+	t.Helper()
+
+	content := orderedmap.New()
+	require.NoError(t, content.UnmarshalJSON([]byte(value)))
+
+	return content
+}
+
+func TestParseJSONPointer(t *testing.T) {
+	t.Parallel()
+
+	tokens, err := common.ParseJSONPointer("/parameters/a~1b/m~0n/0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"parameters", "a/b", "m~n", "0"}, tokens)
+
+	_, err = common.ParseJSONPointer("parameters")
+	require.ErrorIs(t, err, common.ErrInvalidJSONPointer)
+
+	_, err = common.ParseJSONPointer("")
+	require.ErrorIs(t, err, common.ErrInvalidJSONPointer)
+}
+
+func TestCopyJSONPointers(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		source   string
+		target   string
+		pointers []string
+		expected string
+	}{
+		{
+			name:     "replace value",
+			source:   `{"authorization":{"oauth":"remote"},"parameters":{"a":1}}`,
+			target:   `{"authorization":{"oauth":"local"},"parameters":{"a":2}}`,
+			pointers: []string{"/authorization"},
+			expected: `{"authorization":{"oauth":"remote"},"parameters":{"a":2}}`,
+		},
+		{
+			name:     "add missing value with parents",
+			source:   `{"parameters":{"cursor":{"last":10}}}`,
+			target:   `{"storage":{}}`,
+			pointers: []string{"/parameters/cursor/last"},
+			expected: `{"storage":{},"parameters":{"cursor":{"last":10}}}`,
+		},
+		{
+			name:     "remove value missing in source",
+			source:   `{"parameters":{}}`,
+			target:   `{"parameters":{"cursor":5,"a":1}}`,
+			pointers: []string{"/parameters/cursor"},
+			expected: `{"parameters":{"a":1}}`,
+		},
+		{
+			name:     "array item",
+			source:   `{"tables":[{"id":"a","rows":1},{"id":"b","rows":2}]}`,
+			target:   `{"tables":[{"id":"a","rows":0},{"id":"b","rows":0}]}`,
+			pointers: []string{"/tables/1/rows"},
+			expected: `{"tables":[{"id":"a","rows":0},{"id":"b","rows":2}]}`,
+		},
+		{
+			name:     "remove array item",
+			source:   `{"tables":[]}`,
+			target:   `{"tables":["a","b","c"]}`,
+			pointers: []string{"/tables/1"},
+			expected: `{"tables":["a","c"]}`,
+		},
+		{
+			name:     "missing in both",
+			source:   `{}`,
+			target:   `{"a":1}`,
+			pointers: []string{"/b/c"},
+			expected: `{"a":1}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := parseJSON(t, tc.target)
+			require.NoError(t, common.CopyJSONPointers(parseJSON(t, tc.source), target, tc.pointers))
+
+			actual, err := target.MarshalJSON()
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestCopyJSONPointersInvalidPointer(t *testing.T) {
+	t.Parallel()
+
+	err := common.CopyJSONPointers(orderedmap.New(), orderedmap.New(), []string{"authorization"})
+	require.ErrorIs(t, err, common.ErrInvalidJSONPointer)
+}
@@ -0,0 +1,36 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+)
+
+func TestFindMetadata(t *testing.T) {
+	t.Parallel()
+
+	details := keboola.MetadataDetails{
+		{ID: "1", Key: "KBC.description", Value: "first", Provider: "user"},
+		{ID: "2", Key: "KBC.description", Value: "second", Provider: "user"},
+		{ID: "3", Key: "KBC.description", Value: "other", Provider: "terraform"},
+		{ID: "4", Key: "KBC.name", Value: "name", Provider: "user"},
+	}
+
+	// The last entry with the key wins, as in MetadataDetails.ToMap
+	detail := common.FindMetadata(details, "user", "KBC.description")
+	require.NotNil(t, detail)
+	assert.Equal(t, "2", detail.ID)
+	assert.Equal(t, "second", detail.Value)
+
+	// An empty provider matches any provider
+	detail = common.FindMetadata(details, "", "KBC.description")
+	require.NotNil(t, detail)
+	assert.Equal(t, "3", detail.ID)
+
+	assert.Nil(t, common.FindMetadata(details, "terraform", "KBC.name"))
+	assert.Nil(t, common.FindMetadata(details, "user", "missing"))
+}
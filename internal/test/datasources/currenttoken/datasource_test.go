@@ -0,0 +1,32 @@
+package currenttoken_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+)
+
+func TestAccCurrentTokenDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Read the token the provider is configured with
+			{
+				Config: test.ProviderConfig() + `
+data "keboola_current_token" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.keboola_current_token.test", "id"),
+					resource.TestCheckResourceAttrSet("data.keboola_current_token.test", "project_id"),
+					resource.TestCheckResourceAttrSet("data.keboola_current_token.test", "project_name"),
+					resource.TestCheckResourceAttrSet("data.keboola_current_token.test", "is_master"),
+				),
+			},
+		},
+	})
+}
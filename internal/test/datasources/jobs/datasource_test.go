@@ -0,0 +1,213 @@
+package jobs_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/datasources/jobs"
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func TestAccJobsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// The latest job of the configuration is the one run by Terraform
+			{
+				Config: test.ProviderConfig() + `
+resource "keboola_component_configuration" "test" {
+  name         = "test jobs data source"
+  component_id = "ex-generic-v2"
+}
+
+resource "keboola_job" "test" {
+  component_id     = keboola_component_configuration.test.component_id
+  configuration_id = keboola_component_configuration.test.configuration_id
+}
+
+data "keboola_jobs" "test" {
+  component_id     = keboola_component_configuration.test.component_id
+  configuration_id = keboola_component_configuration.test.configuration_id
+  limit            = 1
+  depends_on       = [keboola_job.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.keboola_jobs.test", "branch_id"),
+					resource.TestCheckResourceAttr("data.keboola_jobs.test", "jobs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keboola_jobs.test", "jobs.0.id", "keboola_job.test", "id"),
+					resource.TestCheckResourceAttr("data.keboola_jobs.test", "jobs.0.status", "success"),
+				),
+			},
+		},
+	})
+}
+
+// read reads the data source configured with the in-memory API double.
+func read(t *testing.T, client *double.Client, attributes map[string]attr.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	d := jobs.NewDataSource()
+	d.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: client.ProviderData()}, &datasource.ConfigureResponse{})
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(t.Context(), datasource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	// The configuration is an object with null attributes, unlike a plan it cannot be null
+	objectType, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	require.True(t, ok)
+	nulls := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		nulls[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nulls)}
+	for name, value := range attributes {
+		diags := config.SetAttribute(t.Context(), path.Root(name), value)
+		require.False(t, diags.HasError(), diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(t.Context(), datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+func listed(t *testing.T, state tfsdk.State) []jobs.JobModel {
+	t.Helper()
+
+	var items []jobs.JobModel
+	require.False(t, state.GetAttribute(t.Context(), path.Root("jobs"), &items).HasError())
+
+	return items
+}
+
+func TestJobsDataSourceWithDouble(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+	for _, id := range []keboola.ConfigID{"1", "2"} {
+		key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: id}
+		client.AddConfig(&keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, Content: orderedmap.New()}})
+	}
+
+	run := func(configID keboola.ConfigID) string {
+		job, err := client.CreateJob(t.Context(), apiclient.JobRequest{
+			BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ConfigID: configID,
+		})
+		require.NoError(t, err)
+
+		return job.ID.String()
+	}
+	first := run("1")
+	run("2")
+	client.FinishJobsWith(abstraction.QueueJobStatusError, "Invalid credentials.")
+	latest := run("1")
+
+	// All jobs of the default branch, the newest first
+	state, diags := read(t, client, nil)
+	require.False(t, diags.HasError(), diags)
+	items := listed(t, state)
+	require.Len(t, items, 3)
+	assert.Equal(t, latest, items[0].ID.ValueString())
+
+	var branchID types.Int64
+	require.False(t, state.GetAttribute(t.Context(), path.Root("branch_id"), &branchID).HasError())
+	assert.Equal(t, int64(double.DefaultBranchID), branchID.ValueInt64())
+
+	// The latest run of the configuration has failed
+	state, diags = read(t, client, map[string]attr.Value{
+		"configuration_id": types.StringValue("1"),
+		"limit":            types.Int64Value(1),
+	})
+	require.False(t, diags.HasError(), diags)
+	items = listed(t, state)
+	require.Len(t, items, 1)
+	assert.Equal(t, abstraction.QueueJobStatusError, items[0].Status.ValueString())
+	assert.Equal(t, "Invalid credentials.", items[0].ErrorMessage.ValueString())
+	assert.Equal(t, int64(3), items[0].DurationSeconds.ValueInt64())
+
+	// The successful runs of the configuration have no error
+	state, diags = read(t, client, map[string]attr.Value{
+		"configuration_id": types.StringValue("1"),
+		"statuses":         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("success")}),
+	})
+	require.False(t, diags.HasError(), diags)
+	items = listed(t, state)
+	require.Len(t, items, 1)
+	assert.Equal(t, first, items[0].ID.ValueString())
+	assert.True(t, items[0].ErrorMessage.IsNull())
+
+	// The time window
+	future := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	state, diags = read(t, client, map[string]attr.Value{"created_after": types.StringValue(future)})
+	require.False(t, diags.HasError(), diags)
+	assert.Empty(t, listed(t, state))
+
+	state, diags = read(t, client, map[string]attr.Value{"created_before": types.StringValue(future)})
+	require.False(t, diags.HasError(), diags)
+	assert.Len(t, listed(t, state), 3)
+
+	// The created time of a listed job is accepted by the time window
+	createdTime := items[0].CreatedTime.ValueString()
+	_, err := time.Parse(time.RFC3339, createdTime)
+	require.NoError(t, err)
+	_, err = time.Parse(time.RFC3339, items[0].EndTime.ValueString())
+	require.NoError(t, err)
+
+	state, diags = read(t, client, map[string]attr.Value{"created_after": types.StringValue(createdTime)})
+	require.False(t, diags.HasError(), diags)
+	assert.Contains(t, jobIDs(listed(t, state)), first)
+
+	state, diags = read(t, client, map[string]attr.Value{"created_before": types.StringValue(createdTime)})
+	require.False(t, diags.HasError(), diags)
+	assert.NotContains(t, jobIDs(listed(t, state)), first)
+}
+
+func jobIDs(items []jobs.JobModel) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID.ValueString())
+	}
+
+	return ids
+}
+
+func TestJobsDataSourceWithDoubleErrors(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+
+	_, diags := read(t, client, map[string]attr.Value{"created_after": types.StringValue("yesterday")})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid timestamp", diags.Errors()[0].Summary())
+
+	_, diags = read(t, client, map[string]attr.Value{"limit": types.Int64Value(1000)})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "limit must be from 1 to 500, got 1000", diags.Errors()[0].Detail())
+
+	client.FailNext("SearchJobs", double.ServerError(http.StatusBadGateway))
+	_, diags = read(t, client, nil)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "could not search jobs: Bad Gateway (502)")
+}
@@ -0,0 +1,220 @@
+package double
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+)
+
+// GetStorageJob gets the Storage job.
+func (c *Client) GetStorageJob(_ context.Context, key keboola.StorageJobKey) (*keboola.StorageJob, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("GetStorageJob"); err != nil {
+		return nil, err
+	}
+
+	job, ok := c.jobs[key.ID]
+	if !ok {
+		return nil, NotFound("storage job %d not found", key.ID)
+	}
+
+	clone := *job
+
+	return &clone, nil
+}
+
+// GetDefaultBranch gets the default branch.
+func (c *Client) GetDefaultBranch(_ context.Context) (*keboola.Branch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("GetDefaultBranch"); err != nil {
+		return nil, err
+	}
+
+	clone := *c.branches[DefaultBranchID]
+
+	return &clone, nil
+}
+
+// GetBranch gets the branch.
+func (c *Client) GetBranch(_ context.Context, key keboola.BranchKey) (*keboola.Branch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("GetBranch"); err != nil {
+		return nil, err
+	}
+
+	branch, ok := c.branches[key.ID]
+	if !ok {
+		return nil, NotFound("branch %s not found", key.ID)
+	}
+
+	clone := *branch
+
+	return &clone, nil
+}
+
+// ListBranches lists all branches sorted by the ID, the default branch is the first one.
+func (c *Client) ListBranches(_ context.Context) ([]*keboola.Branch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("ListBranches"); err != nil {
+		return nil, err
+	}
+
+	branches := make([]*keboola.Branch, 0, len(c.branches))
+	for _, branch := range c.branches {
+		clone := *branch
+		branches = append(branches, &clone)
+	}
+	slices.SortFunc(branches, func(a, b *keboola.Branch) int { return cmp.Compare(a.ID, b.ID) })
+
+	return branches, nil
+}
+
+// CreateBranchAsync creates the branch, the returned Storage job is already finished.
+// The branch names are unique, an existing name results in a conflict.
+func (c *Client) CreateBranchAsync(_ context.Context, branch *keboola.Branch) (*keboola.StorageJob, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("CreateBranchAsync"); err != nil {
+		return nil, err
+	}
+
+	if c.branchNameExists(branch.Name, 0) {
+		return nil, Conflict("branch %q already exists", branch.Name)
+	}
+
+	created := &keboola.Branch{
+		BranchKey:   keboola.BranchKey{ID: keboola.BranchID(c.nextID())},
+		Name:        branch.Name,
+		Description: branch.Description,
+	}
+	c.branches[created.ID] = created
+
+	return c.finishedJob("devBranchCreate", keboola.StorageJobResult{
+		"id":          int(created.ID),
+		"name":        created.Name,
+		"description": created.Description,
+		"isDefault":   false,
+	}), nil
+}
+
+// UpdateBranch updates the changed fields of the branch, all fields are updated if changedFields is nil.
+func (c *Client) UpdateBranch(
+	_ context.Context,
+	branch *keboola.Branch,
+	changedFields []string,
+) (*keboola.Branch, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("UpdateBranch"); err != nil {
+		return nil, err
+	}
+
+	target, ok := c.branches[branch.ID]
+	if !ok {
+		return nil, NotFound("branch %s not found", branch.ID)
+	}
+
+	if changedFields == nil || slices.Contains(changedFields, "name") {
+		if c.branchNameExists(branch.Name, branch.ID) {
+			return nil, Conflict("branch %q already exists", branch.Name)
+		}
+		target.Name = branch.Name
+	}
+	if changedFields == nil || slices.Contains(changedFields, "description") {
+		target.Description = branch.Description
+	}
+
+	clone := *target
+
+	return &clone, nil
+}
+
+// DeleteBranchAsync deletes the branch and its configurations, the returned Storage job is already finished.
+func (c *Client) DeleteBranchAsync(_ context.Context, key keboola.BranchKey) (*keboola.StorageJob, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("DeleteBranchAsync"); err != nil {
+		return nil, err
+	}
+
+	branch, ok := c.branches[key.ID]
+	if !ok {
+		return nil, NotFound("branch %s not found", key.ID)
+	}
+
+	if branch.IsDefault {
+		return c.failedJob(
+			"devBranchDelete",
+			"storage.devBranch.cannotDeleteDefault",
+			"The default branch cannot be deleted",
+		), nil
+	}
+
+	delete(c.branches, key.ID)
+	for configKey := range c.configs {
+		if configKey.BranchID == key.ID {
+			delete(c.configs, configKey)
+		}
+	}
+
+	return c.finishedJob("devBranchDelete", nil), nil
+}
+
+// branchNameExists returns true if another branch than the ignored one has the name.
+// The caller must hold the lock.
+func (c *Client) branchNameExists(name string, ignored keboola.BranchID) bool {
+	for id, branch := range c.branches {
+		if id != ignored && branch.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// finishedJob stores a successful Storage job with the results.
+// The caller must hold the lock.
+func (c *Client) finishedJob(operation string, results keboola.StorageJobResult) *keboola.StorageJob {
+	job := &keboola.StorageJob{
+		StorageJobKey: keboola.StorageJobKey{ID: keboola.StorageJobID(c.nextID())},
+		Status:        abstraction.StorageJobStatusSuccess,
+		OperationName: operation,
+		Results:       results,
+	}
+	c.jobs[job.ID] = job
+
+	clone := *job
+
+	return &clone
+}
+
+// failedJob stores a failed Storage job with the error.
+// The caller must hold the lock.
+func (c *Client) failedJob(operation, code, message string) *keboola.StorageJob {
+	job := &keboola.StorageJob{
+		StorageJobKey: keboola.StorageJobKey{ID: keboola.StorageJobID(c.nextID())},
+		Status:        abstraction.StorageJobStatusError,
+		OperationName: operation,
+		Error:         keboola.StorageJobError{Code: code, Message: message},
+	}
+	c.jobs[job.ID] = job
+
+	clone := *job
+
+	return &clone
+}
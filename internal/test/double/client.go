@@ -0,0 +1,166 @@
+// Package double provides an in-memory implementation of the apiclient interfaces,
+// so the resource logic can be tested without HTTP.
+//
+// Unlike the fake package, which serves the Keboola APIs over HTTP for acceptance tests,
+// the double is used directly by the resources, and any call can be made to fail, see Client.FailNext.
+package double
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+)
+
+const (
+	// ProjectID is the ID of the in-memory project.
+	ProjectID = 1
+	// DefaultBranchID is the ID of the default branch of the in-memory project.
+	DefaultBranchID = keboola.BranchID(1)
+)
+
+// Components are the IDs of the components available in the in-memory project.
+var Components = []keboola.ComponentID{ //nolint: gochecknoglobals
+	"ex-generic-v2",
+	"keboola.orchestrator",
+	"keboola.scheduler",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ apiclient.Client = New()
+
+// Error is returned by the failed calls, it has a status code like the errors of the SDK.
+type Error struct {
+	Status  int
+	Message string
+}
+
+// Error returns the message and the status code.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (%d)", e.Message, e.Status)
+}
+
+// StatusCode returns the HTTP status code of the error.
+func (e *Error) StatusCode() int {
+	return e.Status
+}
+
+// NotFound returns a 404 error.
+func NotFound(format string, args ...any) *Error {
+	return &Error{Status: http.StatusNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// Conflict returns a 409 error.
+func Conflict(format string, args ...any) *Error {
+	return &Error{Status: http.StatusConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+// ServerError returns a 5xx error with the status, e.g. http.StatusServiceUnavailable.
+func ServerError(status int) *Error {
+	return &Error{Status: status, Message: http.StatusText(status)}
+}
+
+// Client is an in-memory project implementing all apiclient interfaces.
+// It contains the default branch, further objects are created by the calls.
+type Client struct {
+	mu        sync.Mutex
+	lastID    int
+	failures  map[string][]error
+	calls     map[string]int
+	configs   map[keboola.ConfigKey]*storedConfig
+	branches  map[keboola.BranchID]*keboola.Branch
+	jobs      map[keboola.StorageJobID]*keboola.StorageJob
+	metadata  map[string]keboola.MetadataDetails
+	schedules map[keboola.ScheduleID]*keboola.Schedule
+	queueJobs map[keboola.JobID]*keboola.QueueJob
+
+	queueJobResult queueJobResult
+}
+
+// New creates an in-memory project with the default branch.
+func New() *Client {
+	c := &Client{
+		lastID:    100,
+		failures:  make(map[string][]error),
+		calls:     make(map[string]int),
+		configs:   make(map[keboola.ConfigKey]*storedConfig),
+		branches:  make(map[keboola.BranchID]*keboola.Branch),
+		jobs:      make(map[keboola.StorageJobID]*keboola.StorageJob),
+		metadata:  make(map[string]keboola.MetadataDetails),
+		schedules: make(map[keboola.ScheduleID]*keboola.Schedule),
+		queueJobs: make(map[keboola.JobID]*keboola.QueueJob),
+	}
+
+	c.branches[DefaultBranchID] = &keboola.Branch{
+		BranchKey: keboola.BranchKey{ID: DefaultBranchID},
+		Name:      "Main",
+		IsDefault: true,
+	}
+
+	return c
+}
+
+// ProviderData returns provider data with all API clients replaced by the double, for the Configure of a resource.
+func (c *Client) ProviderData() *providermodels.ProviderData {
+	components := make([]*keboola.Component, 0, len(Components))
+	for _, id := range Components {
+		components = append(components, &keboola.Component{ID: id})
+	}
+
+	return &providermodels.ProviderData{
+		Token:      &keboola.Token{Owner: keboola.TokenOwner{ID: ProjectID, Name: "Double"}},
+		Components: components,
+		Configs:    c,
+		Branches:   c,
+		Metadata:   c,
+		Encryption: c,
+		Schedules:  c,
+		Jobs:       c,
+	}
+}
+
+// FailNext makes the next calls of the method fail, one call per error.
+// The method is the name of the interface method, e.g. "GetConfig".
+func (c *Client) FailNext(method string, errs ...error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failures[method] = append(c.failures[method], errs...)
+}
+
+// Calls returns how many times the method has been called, including the failed calls.
+func (c *Client) Calls(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.calls[method]
+}
+
+// call records the call of the method and returns the injected error, if any.
+// The caller must hold the lock.
+func (c *Client) call(method string) error {
+	c.calls[method]++
+
+	if errs := c.failures[method]; len(errs) > 0 {
+		c.failures[method] = errs[1:]
+
+		return errs[0]
+	}
+
+	return nil
+}
+
+func (c *Client) nextID() int {
+	c.lastID++
+
+	return c.lastID
+}
+
+func (c *Client) nextStringID() string {
+	return strconv.Itoa(c.nextID())
+}
@@ -0,0 +1,101 @@
+package double_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func TestDoubleFailNext(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+	client.FailNext("GetDefaultBranch", double.ServerError(http.StatusBadGateway), double.NotFound("gone"))
+
+	// Each injected error fails a single call, in order
+	_, err := client.GetDefaultBranch(t.Context())
+	var apiErr *double.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode())
+
+	_, err = client.GetDefaultBranch(t.Context())
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+
+	branch, err := client.GetDefaultBranch(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, double.DefaultBranchID, branch.ID)
+	assert.Equal(t, 3, client.Calls("GetDefaultBranch"))
+}
+
+func TestDoubleBranchJob(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+
+	job, err := client.CreateBranchAsync(t.Context(), &keboola.Branch{Name: "feature"})
+	require.NoError(t, err)
+	job, err = abstraction.WaitForStorageJob(t.Context(), client, job)
+	require.NoError(t, err)
+
+	id, ok := job.Results["id"].(int)
+	require.True(t, ok)
+	key := keboola.BranchKey{ID: keboola.BranchID(id)}
+	branch, err := client.GetBranch(t.Context(), key)
+	require.NoError(t, err)
+	assert.Equal(t, "feature", branch.Name)
+
+	// The branch names are unique
+	_, err = client.CreateBranchAsync(t.Context(), &keboola.Branch{Name: "feature"})
+	var apiErr *double.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode())
+
+	// The default branch cannot be deleted, the job fails
+	job, err = client.DeleteBranchAsync(t.Context(), keboola.BranchKey{ID: double.DefaultBranchID})
+	require.NoError(t, err)
+	_, err = abstraction.WaitForStorageJob(t.Context(), client, job)
+	var jobErr *abstraction.StorageJobError
+	require.ErrorAs(t, err, &jobErr)
+
+	job, err = client.DeleteBranchAsync(t.Context(), key)
+	require.NoError(t, err)
+	_, err = abstraction.WaitForStorageJob(t.Context(), client, job)
+	require.NoError(t, err)
+	_, err = client.GetBranch(t.Context(), key)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+}
+
+func TestDoubleMetadata(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+	key := keboola.BucketKey{
+		BranchID: double.DefaultBranchID,
+		BucketID: keboola.BucketID{Stage: "in", BucketName: "c-test"},
+	}
+
+	require.NoError(t, client.AppendBucketMetadata(t.Context(), key, "user", keboola.Metadata{"KBC.description": "a"}))
+	require.NoError(t, client.AppendBucketMetadata(t.Context(), key, "user", keboola.Metadata{"KBC.description": "b"}))
+	require.NoError(t, client.AppendBucketMetadata(t.Context(), key, "other", keboola.Metadata{"KBC.description": "c"}))
+
+	// The entry of the same provider and key is updated
+	details, err := client.ListBucketMetadata(t.Context(), key)
+	require.NoError(t, err)
+	require.Len(t, details, 2)
+	assert.Equal(t, "b", details[0].Value)
+	assert.Equal(t, "c", details[1].Value)
+
+	require.NoError(t, client.DeleteBucketMetadata(t.Context(), key, details[0].ID))
+	err = client.DeleteBucketMetadata(t.Context(), key, details[0].ID)
+	var apiErr *double.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode())
+}
@@ -0,0 +1,419 @@
+package double
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// storedConfig is a configuration with its rows.
+// The first delete moves the configuration to the trash, where it can be restored from, the second one purges it.
+type storedConfig struct {
+	config *keboola.Config
+	rows   []*keboola.ConfigRow
+}
+
+// AddConfig stores the configuration with its rows, e.g. to simulate a configuration created outside of Terraform.
+func (c *Client) AddConfig(config *keboola.ConfigWithRows) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.storeConfig(config, true)
+}
+
+// CreateConfig creates the configuration, the ID is generated if it is empty.
+// An existing configuration with the same ID, also in the trash, results in a conflict.
+func (c *Client) CreateConfig(
+	_ context.Context,
+	config *keboola.ConfigWithRows,
+	withRows bool,
+) (*keboola.ConfigWithRows, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("CreateConfig"); err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.branches[config.BranchID]; !ok {
+		return nil, NotFound("branch %s not found", config.BranchID)
+	}
+
+	if _, ok := c.configs[config.ConfigKey]; ok && config.ID != "" {
+		return nil, Conflict("configuration %s already exists", config.ID)
+	}
+
+	return c.storeConfig(config, withRows).withRows(), nil
+}
+
+// GetConfig gets the configuration, a configuration in the trash is not found.
+func (c *Client) GetConfig(_ context.Context, key keboola.ConfigKey) (*keboola.Config, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("GetConfig"); err != nil {
+		return nil, err
+	}
+
+	stored, err := c.findConfig(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloneConfig(stored.config), nil
+}
+
+// UpdateConfig updates the changed fields of the configuration, all fields are updated if changedFields is nil.
+// Each update increments the version. The rows with the ID of an existing row are updated only by a full update.
+func (c *Client) UpdateConfig(
+	_ context.Context,
+	config *keboola.ConfigWithRows,
+	changedFields []string,
+) (*keboola.ConfigWithRows, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("UpdateConfig"); err != nil {
+		return nil, err
+	}
+
+	stored, err := c.findConfig(config.ConfigKey)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := func(field string) bool {
+		return changedFields == nil || slices.Contains(changedFields, field)
+	}
+
+	target := stored.config
+	if changed("name") {
+		target.Name = config.Name
+	}
+	if changed("description") {
+		target.Description = config.Description
+	}
+	if changed("changeDescription") {
+		target.ChangeDescription = config.ChangeDescription
+	}
+	if changed("isDisabled") {
+		target.IsDisabled = config.IsDisabled
+	}
+	if changed("configuration") {
+		target.Content = cloneMap(config.Content)
+	}
+	if changed("state") {
+		target.State = cloneMap(config.State)
+	}
+	target.Version++
+
+	if changedFields == nil {
+		for _, row := range config.Rows {
+			index := slices.IndexFunc(stored.rows, func(r *keboola.ConfigRow) bool { return r.ID == row.ID })
+			if index < 0 {
+				continue
+			}
+
+			updated := cloneRow(row)
+			updated.ConfigRowKey = stored.rows[index].ConfigRowKey
+			updated.Version = stored.rows[index].Version + 1
+			stored.rows[index] = updated
+		}
+	}
+
+	return stored.withRows(), nil
+}
+
+// RestoreConfig restores the configuration from the trash.
+func (c *Client) RestoreConfig(_ context.Context, key keboola.ConfigKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("RestoreConfig"); err != nil {
+		return err
+	}
+
+	stored, ok := c.configs[key]
+	if !ok || !stored.config.IsDeleted {
+		return NotFound("configuration %s not found in trash", key.ID)
+	}
+
+	stored.config.IsDeleted = false
+	stored.config.Version++
+
+	return nil
+}
+
+// DeleteConfig moves the configuration to the trash, a configuration in the trash is deleted permanently.
+func (c *Client) DeleteConfig(_ context.Context, key keboola.ConfigKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("DeleteConfig"); err != nil {
+		return err
+	}
+
+	stored, ok := c.configs[key]
+	switch {
+	case !ok:
+		return NotFound("configuration %s not found", key.ID)
+	case stored.config.IsDeleted:
+		delete(c.configs, key)
+	default:
+		stored.config.IsDeleted = true
+	}
+
+	return nil
+}
+
+// ListConfigRows lists the rows of the configuration.
+func (c *Client) ListConfigRows(_ context.Context, key keboola.ConfigRowKey) ([]*keboola.ConfigRow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("ListConfigRows"); err != nil {
+		return nil, err
+	}
+
+	stored, err := c.findConfig(configKeyOf(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return stored.withRows().Rows, nil
+}
+
+// ListConfigsAndRows lists the configurations with their rows in the branch, grouped by the component.
+// The components and the configurations are sorted by the ID, the configurations in the trash are not listed.
+func (c *Client) ListConfigsAndRows(
+	_ context.Context,
+	key keboola.BranchKey,
+) ([]*keboola.ComponentWithConfigs, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("ListConfigsAndRows"); err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.branches[key.ID]; !ok {
+		return nil, NotFound("branch %s not found", key.ID)
+	}
+
+	byComponent := make(map[keboola.ComponentID]*keboola.ComponentWithConfigs)
+	for configKey, stored := range c.configs {
+		if configKey.BranchID != key.ID || stored.config.IsDeleted {
+			continue
+		}
+
+		component, ok := byComponent[configKey.ComponentID]
+		if !ok {
+			component = &keboola.ComponentWithConfigs{
+				BranchID:  key.ID,
+				Component: &keboola.Component{ID: configKey.ComponentID},
+			}
+			byComponent[configKey.ComponentID] = component
+		}
+		component.Configs = append(component.Configs, stored.withRows())
+	}
+
+	components := slices.Collect(maps.Values(byComponent))
+	slices.SortFunc(components, func(a, b *keboola.ComponentWithConfigs) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+	for _, component := range components {
+		slices.SortFunc(component.Configs, func(a, b *keboola.ConfigWithRows) int {
+			return strings.Compare(a.ID.String(), b.ID.String())
+		})
+	}
+
+	return components, nil
+}
+
+// CreateConfigRow creates the row, the ID is generated if it is empty.
+// Each created row increments the version of its configuration.
+func (c *Client) CreateConfigRow(_ context.Context, row *keboola.ConfigRow) (*keboola.ConfigRow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("CreateConfigRow"); err != nil {
+		return nil, err
+	}
+
+	stored, err := c.findConfig(configKeyOf(row.ConfigRowKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if row.ID != "" && slices.ContainsFunc(stored.rows, func(r *keboola.ConfigRow) bool { return r.ID == row.ID }) {
+		return nil, Conflict("configuration row %s already exists", row.ID)
+	}
+
+	created := cloneRow(row)
+	if created.ID == "" {
+		created.ID = keboola.RowID(c.nextStringID())
+	}
+	created.Version = 1
+	stored.rows = append(stored.rows, created)
+	stored.config.Version++
+
+	return cloneRow(created), nil
+}
+
+// UpdateConfigRow updates the changed fields of the row, all fields are updated if changedFields is nil.
+// Each update increments the version of the row and of its configuration.
+func (c *Client) UpdateConfigRow(
+	_ context.Context,
+	row *keboola.ConfigRow,
+	changedFields []string,
+) (*keboola.ConfigRow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("UpdateConfigRow"); err != nil {
+		return nil, err
+	}
+
+	stored, err := c.findConfig(configKeyOf(row.ConfigRowKey))
+	if err != nil {
+		return nil, err
+	}
+
+	index := slices.IndexFunc(stored.rows, func(r *keboola.ConfigRow) bool { return r.ID == row.ID })
+	if index < 0 {
+		return nil, NotFound("configuration row %s not found", row.ID)
+	}
+
+	changed := func(field string) bool {
+		return changedFields == nil || slices.Contains(changedFields, field)
+	}
+
+	target := stored.rows[index]
+	if changed("name") {
+		target.Name = row.Name
+	}
+	if changed("description") {
+		target.Description = row.Description
+	}
+	if changed("isDisabled") {
+		target.IsDisabled = row.IsDisabled
+	}
+	if changed("configuration") {
+		target.Content = cloneMap(row.Content)
+	}
+	if changed("state") {
+		target.State = cloneMap(row.State)
+	}
+	target.Version++
+	stored.config.Version++
+
+	return cloneRow(target), nil
+}
+
+// DeleteConfigRow deletes the row, it increments the version of its configuration.
+func (c *Client) DeleteConfigRow(_ context.Context, key keboola.ConfigRowKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("DeleteConfigRow"); err != nil {
+		return err
+	}
+
+	stored, err := c.findConfig(configKeyOf(key))
+	if err != nil {
+		return err
+	}
+
+	index := slices.IndexFunc(stored.rows, func(r *keboola.ConfigRow) bool { return r.ID == key.ID })
+	if index < 0 {
+		return NotFound("configuration row %s not found", key.ID)
+	}
+
+	stored.rows = slices.Delete(stored.rows, index, index+1)
+	stored.config.Version++
+
+	return nil
+}
+
+// storeConfig stores a copy of the configuration, and of its rows if withRows is set.
+// The caller must hold the lock.
+func (c *Client) storeConfig(config *keboola.ConfigWithRows, withRows bool) *storedConfig {
+	stored := &storedConfig{config: cloneConfig(config.Config)}
+	if stored.config.ID == "" {
+		stored.config.ID = keboola.ConfigID(c.nextStringID())
+	}
+	stored.config.Version = 1
+	stored.config.IsDeleted = false
+
+	if withRows {
+		for _, row := range config.Rows {
+			row = cloneRow(row)
+			row.BranchID = stored.config.BranchID
+			row.ComponentID = stored.config.ComponentID
+			row.ConfigID = stored.config.ID
+			if row.ID == "" {
+				row.ID = keboola.RowID(c.nextStringID())
+			}
+			row.Version = 1
+			stored.rows = append(stored.rows, row)
+		}
+	}
+
+	c.configs[stored.config.ConfigKey] = stored
+
+	return stored
+}
+
+// findConfig returns the configuration, if it exists and it is not in the trash.
+// The caller must hold the lock.
+func (c *Client) findConfig(key keboola.ConfigKey) (*storedConfig, error) {
+	stored, ok := c.configs[key]
+	if !ok || stored.config.IsDeleted {
+		return nil, NotFound("configuration %s not found", key.ID)
+	}
+
+	return stored, nil
+}
+
+// withRows returns a copy of the configuration with its rows.
+func (s *storedConfig) withRows() *keboola.ConfigWithRows {
+	rows := make([]*keboola.ConfigRow, 0, len(s.rows))
+	for _, row := range s.rows {
+		rows = append(rows, cloneRow(row))
+	}
+
+	return &keboola.ConfigWithRows{Config: cloneConfig(s.config), Rows: rows}
+}
+
+func configKeyOf(key keboola.ConfigRowKey) keboola.ConfigKey {
+	return keboola.ConfigKey{BranchID: key.BranchID, ComponentID: key.ComponentID, ID: key.ConfigID}
+}
+
+func cloneConfig(config *keboola.Config) *keboola.Config {
+	clone := *config
+	clone.Content = cloneMap(config.Content)
+	clone.State = cloneMap(config.State)
+	clone.RowsSortOrder = slices.Clone(config.RowsSortOrder)
+
+	return &clone
+}
+
+func cloneRow(row *keboola.ConfigRow) *keboola.ConfigRow {
+	clone := *row
+	clone.Content = cloneMap(row.Content)
+	clone.State = cloneMap(row.State)
+
+	return &clone
+}
+
+func cloneMap(m *orderedmap.OrderedMap) *orderedmap.OrderedMap {
+	if m == nil {
+		return orderedmap.New()
+	}
+
+	return m.Clone()
+}
@@ -0,0 +1,46 @@
+package double
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// encryptedPrefix marks values encrypted for the project.
+const encryptedPrefix = "KBC::ProjectSecure::"
+
+// Encrypt encrypts the values of the keys starting with "#", values already encrypted are kept.
+// The cipher is reversible, it only proves the value went through the Encryption API.
+func (c *Client) Encrypt(
+	_ context.Context,
+	projectID int,
+	componentID keboola.ComponentID,
+	values map[string]string,
+) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("Encrypt"); err != nil {
+		return nil, err
+	}
+
+	if projectID != ProjectID {
+		return nil, NotFound("project %d not found", projectID)
+	}
+
+	if componentID == "" {
+		return nil, NotFound("component not found")
+	}
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		if strings.HasPrefix(key, "#") && !strings.HasPrefix(value, "KBC::") {
+			value = encryptedPrefix + base64.StdEncoding.EncodeToString([]byte(value))
+		}
+		result[key] = value
+	}
+
+	return result, nil
+}
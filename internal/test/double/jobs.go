@@ -0,0 +1,156 @@
+package double
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/apiclient"
+)
+
+// jobDuration is the duration of each in-memory Job Queue job.
+const jobDuration = 3 * time.Second
+
+// queueJobResult is the final status and the result message of the Job Queue jobs.
+type queueJobResult struct {
+	status  string
+	message string
+}
+
+// FinishJobsWith makes the next Job Queue jobs finish with the status and the result message,
+// e.g. abstraction.QueueJobStatusError. By default, the jobs succeed.
+// A status which is not final, e.g. abstraction.QueueJobStatusProcessing, keeps the jobs running forever.
+func (c *Client) FinishJobsWith(status, message string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queueJobResult = queueJobResult{status: status, message: message}
+}
+
+// CreateJob runs a job of the configuration, the job is finished immediately, see FinishJobsWith.
+func (c *Client) CreateJob(_ context.Context, request apiclient.JobRequest) (*keboola.QueueJob, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("CreateJob"); err != nil {
+		return nil, err
+	}
+
+	stored, err := c.findConfig(keboola.ConfigKey{
+		BranchID:    request.BranchID,
+		ComponentID: request.ComponentID,
+		ID:          request.ConfigID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rowID := range request.RowIDs {
+		if !slices.ContainsFunc(stored.rows, func(r *keboola.ConfigRow) bool { return r.ID == rowID }) {
+			return nil, NotFound("row %s not found", rowID)
+		}
+	}
+
+	created := time.Now().UTC().Truncate(time.Second)
+	end := created.Add(jobDuration)
+	status := c.queueJobResult.status
+	if status == "" {
+		status = abstraction.QueueJobStatusSuccess
+	}
+
+	job := &keboola.QueueJob{
+		JobKey:      keboola.JobKey{ID: keboola.JobID(c.nextStringID())},
+		BranchID:    request.BranchID,
+		ComponentID: request.ComponentID,
+		ConfigID:    request.ConfigID,
+		Result:      keboola.JobResult{Message: c.queueJobResult.message},
+		Status:      status,
+		CreateTime:  keboola.Time{Time: created},
+		StartTime:   &keboola.Time{Time: created},
+	}
+	job.IsFinished = abstraction.IsQueueJobFinished(job)
+	if job.IsFinished {
+		job.EndTime = &keboola.Time{Time: end}
+	}
+	job.URL = "https://queue.keboola.com/jobs/" + job.ID.String()
+	c.queueJobs[job.ID] = job
+
+	clone := *job
+
+	return &clone, nil
+}
+
+// GetJob gets the Job Queue job.
+func (c *Client) GetJob(_ context.Context, key keboola.JobKey) (*keboola.QueueJob, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("GetJob"); err != nil {
+		return nil, err
+	}
+
+	job, ok := c.queueJobs[key.ID]
+	if !ok {
+		return nil, NotFound("job %s not found", key.ID)
+	}
+
+	clone := *job
+
+	return &clone, nil
+}
+
+// SearchJobs returns the matching Job Queue jobs, the newest first.
+func (c *Client) SearchJobs(_ context.Context, search apiclient.JobSearch) ([]*keboola.QueueJob, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("SearchJobs"); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*keboola.QueueJob, 0)
+	for _, job := range c.queueJobs {
+		if matchesJobSearch(job, search) {
+			clone := *job
+			jobs = append(jobs, &clone)
+		}
+	}
+
+	// The IDs increase, so they order the jobs created within the same second
+	slices.SortFunc(jobs, func(a, b *keboola.QueueJob) int {
+		aID, _ := strconv.Atoi(a.ID.String())
+		bID, _ := strconv.Atoi(b.ID.String())
+
+		return cmp.Compare(bID, aID)
+	})
+
+	if search.Limit > 0 && len(jobs) > search.Limit {
+		jobs = jobs[:search.Limit]
+	}
+
+	return jobs, nil
+}
+
+func matchesJobSearch(job *keboola.QueueJob, search apiclient.JobSearch) bool {
+	switch {
+	case search.BranchID != 0 && job.BranchID != search.BranchID:
+		return false
+	case search.ComponentID != "" && job.ComponentID != search.ComponentID:
+		return false
+	case search.ConfigID != "" && job.ConfigID != search.ConfigID:
+		return false
+	case len(search.Statuses) > 0 && !slices.Contains(search.Statuses, job.Status):
+		return false
+	case !search.CreatedFrom.IsZero() && job.CreateTime.Before(search.CreatedFrom):
+		return false
+	case !search.CreatedTo.IsZero() && !job.CreateTime.Before(search.CreatedTo):
+		return false
+	default:
+		return true
+	}
+}
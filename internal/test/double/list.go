@@ -0,0 +1,97 @@
+package double
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// ListResource calls the list resource configured with the double, the way terraform query calls it.
+type ListResource struct {
+	list.ListResource
+	Client   *Client
+	resource *Resource
+	config   tfsdk.Plan
+}
+
+// NewListResource configures the list resource with the client,
+// the results are built from the schema and the identity schema of the resource r.
+func NewListResource(t *testing.T, client *Client, l list.ListResource, r resource.Resource) *ListResource {
+	t.Helper()
+
+	if configurable, ok := l.(list.ListResourceWithConfigure); ok {
+		configurable.Configure(
+			t.Context(), resource.ConfigureRequest{ProviderData: client.ProviderData()}, &resource.ConfigureResponse{},
+		)
+	}
+
+	schemaResp := &list.ListResourceSchemaResponse{}
+	l.ListResourceConfigSchema(t.Context(), list.ListResourceSchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	// The configuration is known, the attributes which are not set are null
+	configType, _ := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, attributeType := range configType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, attributes)}
+
+	res := NewResource(t, client, r)
+	require.NotNil(t, res.identity, "the listed resource has no identity schema")
+
+	return &ListResource{ListResource: l, Client: client, resource: res, config: config}
+}
+
+// List returns all results of the list block with the attributes, the other attributes are null.
+func (l *ListResource) List(t *testing.T, attributes map[string]attr.Value, includeResource bool) []list.ListResult {
+	t.Helper()
+
+	config := l.config
+	for name, value := range attributes {
+		diags := config.SetAttribute(t.Context(), path.Root(name), value)
+		require.False(t, diags.HasError(), diags)
+	}
+
+	stream := &list.ListResultsStream{}
+	l.ListResource.List(t.Context(), list.ListRequest{
+		Config:                 tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		IncludeResource:        includeResource,
+		ResourceSchema:         l.resource.empty.Schema,
+		ResourceIdentitySchema: l.resource.identity.Schema,
+	}, stream)
+	require.NotNil(t, stream.Results, "the list resource did not set the results")
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// State returns the resource of the result as a state, the result must include the resource.
+func State(t *testing.T, result list.ListResult) tfsdk.State {
+	t.Helper()
+
+	require.NotNil(t, result.Resource, "the result does not include the resource")
+
+	return tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw}
+}
+
+// ListIdentityAttribute returns the value of the attribute of the identity of the result.
+func ListIdentityAttribute[T attr.Value](t *testing.T, result list.ListResult, name string) T {
+	t.Helper()
+
+	var value T
+	diags := result.Identity.GetAttribute(t.Context(), path.Root(name), &value)
+	require.False(t, diags.HasError(), diags)
+
+	return value
+}
@@ -0,0 +1,163 @@
+package double
+
+import (
+	"context"
+	"slices"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// ListBranchMetadata lists all metadata of the branch.
+func (c *Client) ListBranchMetadata(ctx context.Context, key keboola.BranchKey) (keboola.MetadataDetails, error) {
+	return c.listMetadata(ctx, "ListBranchMetadata", "branch/"+key.ID.String())
+}
+
+// AppendBranchMetadata appends metadata to the branch.
+func (c *Client) AppendBranchMetadata(ctx context.Context, key keboola.BranchKey, metadata keboola.Metadata) error {
+	return c.appendMetadata(ctx, "AppendBranchMetadata", "branch/"+key.ID.String(), "", metadata)
+}
+
+// DeleteBranchMetadata deletes a single metadata entry of the branch.
+func (c *Client) DeleteBranchMetadata(ctx context.Context, key keboola.BranchKey, metadataID string) error {
+	return c.deleteMetadata(ctx, "DeleteBranchMetadata", "branch/"+key.ID.String(), metadataID)
+}
+
+// ListBucketMetadata lists all metadata of the bucket.
+func (c *Client) ListBucketMetadata(ctx context.Context, key keboola.BucketKey) (keboola.MetadataDetails, error) {
+	return c.listMetadata(ctx, "ListBucketMetadata", bucketTarget(key))
+}
+
+// AppendBucketMetadata appends metadata to the bucket.
+func (c *Client) AppendBucketMetadata(
+	ctx context.Context,
+	key keboola.BucketKey,
+	provider string,
+	metadata keboola.Metadata,
+) error {
+	return c.appendMetadata(ctx, "AppendBucketMetadata", bucketTarget(key), provider, metadata)
+}
+
+// DeleteBucketMetadata deletes a single metadata entry of the bucket.
+func (c *Client) DeleteBucketMetadata(ctx context.Context, key keboola.BucketKey, metadataID string) error {
+	return c.deleteMetadata(ctx, "DeleteBucketMetadata", bucketTarget(key), metadataID)
+}
+
+// ListTableMetadata lists all metadata of the table.
+func (c *Client) ListTableMetadata(ctx context.Context, key keboola.TableKey) (keboola.MetadataDetails, error) {
+	return c.listMetadata(ctx, "ListTableMetadata", tableTarget(key))
+}
+
+// AppendTableMetadata appends metadata to the table.
+func (c *Client) AppendTableMetadata(
+	ctx context.Context,
+	key keboola.TableKey,
+	provider string,
+	metadata keboola.Metadata,
+) error {
+	return c.appendMetadata(ctx, "AppendTableMetadata", tableTarget(key), provider, metadata)
+}
+
+// DeleteTableMetadata deletes a single metadata entry of the table.
+func (c *Client) DeleteTableMetadata(ctx context.Context, key keboola.TableKey, metadataID string) error {
+	return c.deleteMetadata(ctx, "DeleteTableMetadata", tableTarget(key), metadataID)
+}
+
+// ListColumnMetadata lists all metadata of the table column.
+func (c *Client) ListColumnMetadata(
+	ctx context.Context,
+	key keboola.TableKey,
+	column string,
+) (keboola.MetadataDetails, error) {
+	return c.listMetadata(ctx, "ListColumnMetadata", tableTarget(key)+"/"+column)
+}
+
+// AppendColumnMetadata appends metadata to the table column.
+func (c *Client) AppendColumnMetadata(
+	ctx context.Context,
+	key keboola.TableKey,
+	column, provider string,
+	metadata keboola.Metadata,
+) error {
+	return c.appendMetadata(ctx, "AppendColumnMetadata", tableTarget(key)+"/"+column, provider, metadata)
+}
+
+// DeleteColumnMetadata deletes a single metadata entry of the table column.
+func (c *Client) DeleteColumnMetadata(ctx context.Context, key keboola.TableKey, column, metadataID string) error {
+	return c.deleteMetadata(ctx, "DeleteColumnMetadata", tableTarget(key)+"/"+column, metadataID)
+}
+
+// listMetadata lists the metadata of the target, a target without metadata has an empty list.
+func (c *Client) listMetadata(_ context.Context, method, target string) (keboola.MetadataDetails, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(method); err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(c.metadata[target]), nil
+}
+
+// appendMetadata sets the values of the keys, an existing entry with the same provider and key is updated.
+func (c *Client) appendMetadata(
+	_ context.Context,
+	method, target, provider string,
+	metadata keboola.Metadata,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(method); err != nil {
+		return err
+	}
+
+	details := c.metadata[target]
+	for key, value := range metadata {
+		index := slices.IndexFunc(details, func(d keboola.MetadataDetail) bool {
+			return d.Provider == provider && d.Key == key
+		})
+		if index >= 0 {
+			details[index].Value = value
+
+			continue
+		}
+
+		details = append(details, keboola.MetadataDetail{
+			ID:       c.nextStringID(),
+			Key:      key,
+			Value:    value,
+			Provider: provider,
+		})
+	}
+	c.metadata[target] = details
+
+	return nil
+}
+
+// deleteMetadata deletes the entry of the target.
+func (c *Client) deleteMetadata(_ context.Context, method, target, metadataID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(method); err != nil {
+		return err
+	}
+
+	details := c.metadata[target]
+	index := slices.IndexFunc(details, func(d keboola.MetadataDetail) bool { return d.ID == metadataID })
+	if index < 0 {
+		return NotFound("metadata %s not found", metadataID)
+	}
+
+	c.metadata[target] = slices.Delete(details, index, index+1)
+
+	return nil
+}
+
+func bucketTarget(key keboola.BucketKey) string {
+	return "bucket/" + key.BranchID.String() + "/" + key.BucketID.String()
+}
+
+func tableTarget(key keboola.TableKey) string {
+	return "table/" + key.BranchID.String() + "/" + key.TableID.String()
+}
@@ -0,0 +1,215 @@
+package double
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// Resource calls the methods of a resource configured with the double, the way Terraform calls them.
+type Resource struct {
+	resource.Resource
+	Client *Client
+	// Identity is the identity returned by the last call, it is nil if the resource has no identity schema.
+	Identity *tfsdk.ResourceIdentity
+	empty    tfsdk.Plan
+	identity *tfsdk.ResourceIdentity
+}
+
+// NewResource configures the resource with the client.
+func NewResource(t *testing.T, client *Client, r resource.Resource) *Resource {
+	t.Helper()
+
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(
+			t.Context(), resource.ConfigureRequest{ProviderData: client.ProviderData()}, &resource.ConfigureResponse{},
+		)
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(t.Context(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	empty := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(t.Context()), nil),
+	}
+
+	// The identity is null until the resource sets it
+	var identity *tfsdk.ResourceIdentity
+	if withIdentity, ok := r.(resource.ResourceWithIdentity); ok {
+		identityResp := &resource.IdentitySchemaResponse{}
+		withIdentity.IdentitySchema(t.Context(), resource.IdentitySchemaRequest{}, identityResp)
+		require.False(t, identityResp.Diagnostics.HasError(), identityResp.Diagnostics)
+
+		identity = &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(t.Context()), nil),
+		}
+	}
+
+	return &Resource{Resource: r, Client: client, empty: empty, identity: identity}
+}
+
+// Plan returns a plan with the attributes, the other attributes are null.
+func (r *Resource) Plan(t *testing.T, attributes map[string]attr.Value) tfsdk.Plan {
+	t.Helper()
+
+	plan := r.empty
+	for name, value := range attributes {
+		diags := plan.SetAttribute(t.Context(), path.Root(name), value)
+		require.False(t, diags.HasError(), diags)
+	}
+
+	return plan
+}
+
+// Create creates the resource, the returned state is empty if the resource did not set it.
+func (r *Resource) Create(t *testing.T, plan tfsdk.Plan) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: r.empty.Raw}, Identity: r.nullIdentity()}
+	r.Resource.Create(t.Context(), resource.CreateRequest{Plan: plan, Identity: r.nullIdentity()}, resp)
+	r.Identity = resp.Identity
+
+	return resp.State, resp.Diagnostics
+}
+
+// Read refreshes the state, the returned state is null if the resource has been removed.
+func (r *Resource) Read(t *testing.T, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	resp := &resource.ReadResponse{State: state, Identity: r.currentIdentity()}
+	r.Resource.Read(t.Context(), resource.ReadRequest{State: state, Identity: r.currentIdentity()}, resp)
+	r.Identity = resp.Identity
+
+	return resp.State, resp.Diagnostics
+}
+
+// Update applies the plan to the state.
+func (r *Resource) Update(t *testing.T, state tfsdk.State, plan tfsdk.Plan) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	resp := &resource.UpdateResponse{State: state, Identity: r.currentIdentity()}
+	r.Resource.Update(t.Context(), resource.UpdateRequest{State: state, Plan: plan, Identity: r.currentIdentity()}, resp)
+	r.Identity = resp.Identity
+
+	return resp.State, resp.Diagnostics
+}
+
+// Delete deletes the resource of the state.
+func (r *Resource) Delete(t *testing.T, state tfsdk.State) diag.Diagnostics {
+	t.Helper()
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Resource.Delete(t.Context(), resource.DeleteRequest{State: state}, resp)
+
+	return resp.Diagnostics
+}
+
+// ImportState imports the resource by the ID, the resource must implement resource.ResourceWithImportState.
+func (r *Resource) ImportState(t *testing.T, id string) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	return r.importState(t, resource.ImportStateRequest{ID: id})
+}
+
+// ImportStateByIdentity imports the resource by the identity with the attributes,
+// the resource must implement resource.ResourceWithIdentity.
+func (r *Resource) ImportStateByIdentity(
+	t *testing.T,
+	attributes map[string]attr.Value,
+) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	identity := r.nullIdentity()
+	require.NotNil(t, identity, "the resource has no identity schema")
+	for name, value := range attributes {
+		diags := identity.SetAttribute(t.Context(), path.Root(name), value)
+		require.False(t, diags.HasError(), diags)
+	}
+
+	return r.importState(t, resource.ImportStateRequest{Identity: identity})
+}
+
+func (r *Resource) importState(t *testing.T, req resource.ImportStateRequest) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	importer, ok := r.Resource.(resource.ResourceWithImportState)
+	require.True(t, ok, "the resource does not support import")
+
+	identity := req.Identity
+	if identity == nil {
+		identity = r.nullIdentity()
+	}
+
+	resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: r.empty.Schema, Raw: r.empty.Raw}, Identity: identity}
+	importer.ImportState(t.Context(), req, resp)
+	r.Identity = resp.Identity
+
+	return resp.State, resp.Diagnostics
+}
+
+// nullIdentity returns a new null identity, or nil if the resource has no identity schema.
+func (r *Resource) nullIdentity() *tfsdk.ResourceIdentity {
+	if r.identity == nil {
+		return nil
+	}
+
+	identity := *r.identity
+
+	return &identity
+}
+
+// currentIdentity returns a copy of the identity returned by the last call, or a null identity.
+func (r *Resource) currentIdentity() *tfsdk.ResourceIdentity {
+	if r.Identity == nil {
+		return r.nullIdentity()
+	}
+
+	identity := *r.Identity
+
+	return &identity
+}
+
+// StringAttribute returns the value of the string attribute of the state.
+func StringAttribute(t *testing.T, state tfsdk.State, name string) string {
+	t.Helper()
+
+	var value types.String
+	diags := state.GetAttribute(t.Context(), path.Root(name), &value)
+	require.False(t, diags.HasError(), diags)
+
+	return value.ValueString()
+}
+
+// IdentityAttribute returns the value of the attribute of the identity returned by the last call.
+func IdentityAttribute[T attr.Value](t *testing.T, r *Resource, name string) T {
+	t.Helper()
+
+	require.NotNil(t, r.Identity, "the resource has no identity")
+
+	var value T
+	diags := r.Identity.GetAttribute(t.Context(), path.Root(name), &value)
+	require.False(t, diags.HasError(), diags)
+
+	return value
+}
+
+// Int64Attribute returns the value of the number attribute of the state.
+func Int64Attribute(t *testing.T, state tfsdk.State, name string) int64 {
+	t.Helper()
+
+	var value types.Int64
+	diags := state.GetAttribute(t.Context(), path.Root(name), &value)
+	require.False(t, diags.HasError(), diags)
+
+	return value.ValueInt64()
+}
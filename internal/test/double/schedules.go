@@ -0,0 +1,114 @@
+package double
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+)
+
+// schedulerComponentID is the component of the configurations read by the Scheduler API.
+const schedulerComponentID = keboola.ComponentID("keboola.scheduler")
+
+// ActivateSchedule creates a schedule from a keboola.scheduler configuration in the default branch.
+// An existing schedule of the configuration is replaced, an empty version means the current one.
+func (c *Client) ActivateSchedule(
+	_ context.Context,
+	configID keboola.ConfigID,
+	configVersionID string,
+) (*keboola.Schedule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("ActivateSchedule"); err != nil {
+		return nil, err
+	}
+
+	stored, err := c.findConfig(keboola.ConfigKey{
+		BranchID:    DefaultBranchID,
+		ComponentID: schedulerComponentID,
+		ID:          configID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if configVersionID == "" {
+		configVersionID = strconv.Itoa(stored.config.Version)
+	}
+
+	for id, schedule := range c.schedules {
+		if schedule.ConfigID == configID {
+			delete(c.schedules, id)
+		}
+	}
+
+	created := &keboola.Schedule{
+		ScheduleKey:            keboola.ScheduleKey{ID: keboola.ScheduleID(c.nextStringID())},
+		ConfigID:               configID,
+		ConfigurationVersionID: configVersionID,
+	}
+	c.schedules[created.ID] = created
+
+	clone := *created
+
+	return &clone, nil
+}
+
+// GetSchedule gets the schedule.
+func (c *Client) GetSchedule(_ context.Context, key keboola.ScheduleKey) (*keboola.Schedule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("GetSchedule"); err != nil {
+		return nil, err
+	}
+
+	schedule, ok := c.schedules[key.ID]
+	if !ok {
+		return nil, NotFound("schedule %s not found", key.ID)
+	}
+
+	clone := *schedule
+
+	return &clone, nil
+}
+
+// ListSchedules lists all schedules sorted by the ID.
+func (c *Client) ListSchedules(_ context.Context) ([]*keboola.Schedule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("ListSchedules"); err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*keboola.Schedule, 0, len(c.schedules))
+	for _, schedule := range c.schedules {
+		clone := *schedule
+		schedules = append(schedules, &clone)
+	}
+	slices.SortFunc(schedules, func(a, b *keboola.Schedule) int { return strings.Compare(string(a.ID), string(b.ID)) })
+
+	return schedules, nil
+}
+
+// DeleteSchedule deletes the schedule.
+func (c *Client) DeleteSchedule(_ context.Context, key keboola.ScheduleKey) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call("DeleteSchedule"); err != nil {
+		return err
+	}
+
+	if _, ok := c.schedules[key.ID]; !ok {
+		return NotFound("schedule %s not found", key.ID)
+	}
+
+	delete(c.schedules, key.ID)
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package fake
+
+import (
+	"net/http"
+	"slices"
+)
+
+const (
+	// BucketID is the ID of the bucket of the fake project, the provider cannot create buckets.
+	BucketID = "in.c-fake"
+	// TableID is the ID of the table in the bucket of the fake project.
+	TableID = BucketID + ".users"
+)
+
+// TableColumns are the columns of the table of the fake project.
+var TableColumns = []string{"id", "name"} //nolint: gochecknoglobals
+
+// objectKey identifies a bucket, table or column in a branch, e.g. "bucket/in.c-fake".
+type objectKey struct {
+	branchID int
+	object   string
+}
+
+func (s *Server) listBucketMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.bucketMetadataTarget(w, r); ok {
+		writeJSON(w, http.StatusOK, s.objectMetadata(target))
+	}
+}
+
+func (s *Server) appendBucketMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.bucketMetadataTarget(w, r); ok {
+		s.appendObjectMetadata(w, r, target)
+	}
+}
+
+func (s *Server) deleteBucketMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.bucketMetadataTarget(w, r); ok {
+		s.deleteObjectMetadata(w, r, target)
+	}
+}
+
+func (s *Server) listTableMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.tableMetadataTarget(w, r); ok {
+		writeJSON(w, http.StatusOK, s.objectMetadata(target))
+	}
+}
+
+func (s *Server) appendTableMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.tableMetadataTarget(w, r); ok {
+		s.appendObjectMetadata(w, r, target)
+	}
+}
+
+func (s *Server) deleteTableMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.tableMetadataTarget(w, r); ok {
+		s.deleteObjectMetadata(w, r, target)
+	}
+}
+
+func (s *Server) listColumnMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.columnMetadataTarget(w, r); ok {
+		writeJSON(w, http.StatusOK, s.objectMetadata(target))
+	}
+}
+
+func (s *Server) appendColumnMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.columnMetadataTarget(w, r); ok {
+		s.appendObjectMetadata(w, r, target)
+	}
+}
+
+func (s *Server) deleteColumnMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if target, ok := s.columnMetadataTarget(w, r); ok {
+		s.deleteObjectMetadata(w, r, target)
+	}
+}
+
+// bucketMetadataTarget returns the key of the bucket metadata, the bucket must exist in the branch.
+func (s *Server) bucketMetadataTarget(w http.ResponseWriter, r *http.Request) (objectKey, bool) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return objectKey{}, false
+	}
+
+	if bucket := r.PathValue("bucket"); bucket != BucketID {
+		writeError(w, http.StatusNotFound, "storage.buckets.notFound", "Bucket %s not found", bucket)
+
+		return objectKey{}, false
+	}
+
+	return objectKey{branchID: b.ID, object: "bucket/" + BucketID}, true
+}
+
+// tableMetadataTarget returns the key of the table metadata, the table must exist in the branch.
+func (s *Server) tableMetadataTarget(w http.ResponseWriter, r *http.Request) (objectKey, bool) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return objectKey{}, false
+	}
+
+	if table := r.PathValue("table"); table != TableID {
+		writeError(w, http.StatusNotFound, "storage.tables.notFound", "Table %s not found", table)
+
+		return objectKey{}, false
+	}
+
+	return objectKey{branchID: b.ID, object: "table/" + TableID}, true
+}
+
+// columnMetadataTarget returns the key of the column metadata, the column ID is the table ID and the column name.
+func (s *Server) columnMetadataTarget(w http.ResponseWriter, r *http.Request) (objectKey, bool) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return objectKey{}, false
+	}
+
+	column := r.PathValue("column")
+	if !slices.ContainsFunc(TableColumns, func(name string) bool { return column == TableID+"."+name }) {
+		writeError(w, http.StatusNotFound, "storage.tables.columnNotFound", "Column %s not found", column)
+
+		return objectKey{}, false
+	}
+
+	return objectKey{branchID: b.ID, object: "column/" + column}, true
+}
+
+// appendObjectMetadata sets the values of the keys, an existing entry with the same provider and key is updated.
+func (s *Server) appendObjectMetadata(w http.ResponseWriter, r *http.Request, target objectKey) {
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	provider := values.Get("provider")
+	if provider == "" {
+		writeError(w, http.StatusBadRequest, "storage.metadata.validation", "Metadata provider must not be empty")
+
+		return
+	}
+
+	for _, item := range indexedValues(values, "metadata") {
+		if item["key"] == "" {
+			writeError(w, http.StatusBadRequest, "storage.metadata.validation", "Metadata key must not be empty")
+
+			return
+		}
+
+		existing := slices.IndexFunc(s.storageMetadata[target], func(m *metadataItem) bool {
+			return m.Provider == provider && m.Key == item["key"]
+		})
+		if existing >= 0 {
+			s.storageMetadata[target][existing].Value = item["value"]
+			s.storageMetadata[target][existing].Timestamp = now()
+
+			continue
+		}
+
+		s.storageMetadata[target] = append(s.storageMetadata[target], &metadataItem{
+			ID:        s.nextID(),
+			Key:       item["key"],
+			Value:     item["value"],
+			Provider:  provider,
+			Timestamp: now(),
+		})
+	}
+
+	writeJSON(w, http.StatusCreated, s.objectMetadata(target))
+}
+
+func (s *Server) deleteObjectMetadata(w http.ResponseWriter, r *http.Request, target objectKey) {
+	id := r.PathValue("metadata")
+	index := slices.IndexFunc(s.storageMetadata[target], func(m *metadataItem) bool { return m.ID == id })
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "storage.metadata.notFound", "Metadata %s not found", id)
+
+		return
+	}
+
+	s.storageMetadata[target] = slices.Delete(s.storageMetadata[target], index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) objectMetadata(target objectKey) []*metadataItem {
+	if items := s.storageMetadata[target]; items != nil {
+		return items
+	}
+
+	return []*metadataItem{}
+}
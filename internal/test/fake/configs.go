@@ -0,0 +1,414 @@
+package fake
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+type configKey struct {
+	branchID    int
+	componentID string
+	configID    string
+}
+
+// config is a component configuration.
+// The first delete moves the configuration to the trash, where it can be restored from, the second one purges it.
+type config struct {
+	ID                string          `json:"id"`
+	Name              string          `json:"name"`
+	Description       string          `json:"description"`
+	ChangeDescription string          `json:"changeDescription"`
+	Created           string          `json:"created"`
+	Version           int             `json:"version"`
+	IsDisabled        bool            `json:"isDisabled"`
+	IsDeleted         bool            `json:"isDeleted"`
+	Configuration     json.RawMessage `json:"configuration"`
+	State             json.RawMessage `json:"state"`
+	RowsSortOrder     []string        `json:"rowsSortOrder"`
+	Rows              []*configRow    `json:"rows"`
+}
+
+// configRow is a row of a component configuration, each change of a row increments the configuration version.
+type configRow struct {
+	ID                string          `json:"id"`
+	Name              string          `json:"name"`
+	Description       string          `json:"description"`
+	ChangeDescription string          `json:"changeDescription"`
+	IsDisabled        bool            `json:"isDisabled"`
+	Version           int             `json:"version"`
+	Configuration     json.RawMessage `json:"configuration"`
+	State             json.RawMessage `json:"state"`
+}
+
+func (s *Server) listConfigs(w http.ResponseWriter, r *http.Request, _ *token) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return
+	}
+
+	configs := make([]*config, 0)
+	for key, c := range s.configs {
+		if key.branchID == b.ID && key.componentID == r.PathValue("component") && !c.IsDeleted {
+			configs = append(configs, c)
+		}
+	}
+	slices.SortFunc(configs, func(a, b *config) int { return strings.Compare(a.ID, b.ID) })
+
+	writeJSON(w, http.StatusOK, configs)
+}
+
+// componentWithConfigs is a component with its configurations, listed with include=configuration,rows.
+type componentWithConfigs struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Name           string    `json:"name"`
+	Configurations []*config `json:"configurations"`
+}
+
+// listComponents lists the components with configurations in the branch, the configurations include their rows.
+func (s *Server) listComponents(w http.ResponseWriter, r *http.Request, _ *token) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return
+	}
+
+	byComponent := make(map[string]*componentWithConfigs)
+	for key, c := range s.configs {
+		if key.branchID != b.ID || c.IsDeleted {
+			continue
+		}
+
+		if _, ok := byComponent[key.componentID]; !ok {
+			byComponent[key.componentID] = &componentWithConfigs{ID: key.componentID, Type: "other", Name: key.componentID}
+		}
+		byComponent[key.componentID].Configurations = append(byComponent[key.componentID].Configurations, c)
+	}
+
+	components := slices.Collect(maps.Values(byComponent))
+	slices.SortFunc(components, func(a, b *componentWithConfigs) int { return strings.Compare(a.ID, b.ID) })
+	for _, c := range components {
+		slices.SortFunc(c.Configurations, func(a, b *config) int { return strings.Compare(a.ID, b.ID) })
+	}
+
+	writeJSON(w, http.StatusOK, components)
+}
+
+func (s *Server) createConfig(w http.ResponseWriter, r *http.Request, _ *token) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return
+	}
+
+	componentID := r.PathValue("component")
+	if !slices.Contains(Components, componentID) {
+		writeError(w, http.StatusBadRequest, "storage.components.notFound", "Component %s not found", componentID)
+
+		return
+	}
+
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	id := values.Get("configurationId")
+	if id == "" {
+		id = s.nextID()
+	}
+
+	key := configKey{branchID: b.ID, componentID: componentID, configID: id}
+	if _, exists := s.configs[key]; exists {
+		writeError(w, http.StatusBadRequest, "configurationAlreadyExists", "Configuration %s already exists", id)
+
+		return
+	}
+
+	c := &config{
+		ID:                id,
+		Created:           now(),
+		Version:           1,
+		ChangeDescription: "Configuration created",
+		Configuration:     json.RawMessage("{}"),
+		State:             json.RawMessage("{}"),
+		RowsSortOrder:     []string{},
+		Rows:              []*configRow{},
+	}
+	if err := applyConfigValues(c, values); err != nil {
+		writeError(w, http.StatusBadRequest, "storage.configurations.validation", "%s", err)
+
+		return
+	}
+
+	s.configs[key] = c
+	writeJSON(w, http.StatusCreated, c)
+}
+
+func (s *Server) getConfig(w http.ResponseWriter, r *http.Request, _ *token) {
+	if c, ok := s.findConfig(w, r); ok {
+		writeJSON(w, http.StatusOK, c)
+	}
+}
+
+func (s *Server) updateConfig(w http.ResponseWriter, r *http.Request, _ *token) {
+	c, ok := s.findConfig(w, r)
+	if !ok {
+		return
+	}
+
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	c.ChangeDescription = "Configuration updated"
+	if err := applyConfigValues(c, values); err != nil {
+		writeError(w, http.StatusBadRequest, "storage.configurations.validation", "%s", err)
+
+		return
+	}
+
+	c.Version++
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) deleteConfig(w http.ResponseWriter, r *http.Request, _ *token) {
+	key, c, ok := s.findConfigIncludingDeleted(w, r)
+	if !ok {
+		return
+	}
+
+	if c.IsDeleted {
+		delete(s.configs, key)
+	} else {
+		c.IsDeleted = true
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) restoreConfig(w http.ResponseWriter, r *http.Request, _ *token) {
+	_, c, ok := s.findConfigIncludingDeleted(w, r)
+	if !ok {
+		return
+	}
+
+	if !c.IsDeleted {
+		writeError(w, http.StatusBadRequest, "storage.configurations.notDeleted", "Configuration %s is not deleted", c.ID)
+
+		return
+	}
+
+	c.IsDeleted = false
+	c.ChangeDescription = "Configuration restored"
+	c.Version++
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) listConfigRows(w http.ResponseWriter, r *http.Request, _ *token) {
+	if c, ok := s.findConfig(w, r); ok {
+		writeJSON(w, http.StatusOK, c.Rows)
+	}
+}
+
+func (s *Server) createConfigRow(w http.ResponseWriter, r *http.Request, _ *token) {
+	c, ok := s.findConfig(w, r)
+	if !ok {
+		return
+	}
+
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	id := values.Get("rowId")
+	if id == "" {
+		id = s.nextID()
+	}
+
+	if slices.ContainsFunc(c.Rows, func(row *configRow) bool { return row.ID == id }) {
+		writeError(w, http.StatusBadRequest, "configurationRowAlreadyExists", "Row %s already exists", id)
+
+		return
+	}
+
+	row := &configRow{
+		ID:                id,
+		Version:           1,
+		ChangeDescription: "Row created",
+		Configuration:     json.RawMessage("{}"),
+		State:             json.RawMessage("{}"),
+	}
+	if err := applyRowValues(row, values); err != nil {
+		writeError(w, http.StatusBadRequest, "storage.configurations.validation", "%s", err)
+
+		return
+	}
+
+	c.Rows = append(c.Rows, row)
+	c.Version++
+	writeJSON(w, http.StatusCreated, row)
+}
+
+func (s *Server) getConfigRow(w http.ResponseWriter, r *http.Request, _ *token) {
+	if _, row, ok := s.findConfigRow(w, r); ok {
+		writeJSON(w, http.StatusOK, row)
+	}
+}
+
+func (s *Server) updateConfigRow(w http.ResponseWriter, r *http.Request, _ *token) {
+	c, row, ok := s.findConfigRow(w, r)
+	if !ok {
+		return
+	}
+
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	row.ChangeDescription = "Row updated"
+	if err := applyRowValues(row, values); err != nil {
+		writeError(w, http.StatusBadRequest, "storage.configurations.validation", "%s", err)
+
+		return
+	}
+
+	row.Version++
+	c.Version++
+	writeJSON(w, http.StatusOK, row)
+}
+
+func (s *Server) deleteConfigRow(w http.ResponseWriter, r *http.Request, _ *token) {
+	c, row, ok := s.findConfigRow(w, r)
+	if !ok {
+		return
+	}
+
+	c.Rows = slices.DeleteFunc(c.Rows, func(item *configRow) bool { return item == row })
+	c.RowsSortOrder = slices.DeleteFunc(c.RowsSortOrder, func(id string) bool { return id == row.ID })
+	c.Version++
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findConfig returns the configuration from the path, configurations in the trash are not found.
+func (s *Server) findConfig(w http.ResponseWriter, r *http.Request) (*config, bool) {
+	_, c, ok := s.findConfigIncludingDeleted(w, r)
+	if ok && c.IsDeleted {
+		writeError(w, http.StatusNotFound, "notFound", "Configuration %s not found", c.ID)
+
+		return nil, false
+	}
+
+	return c, ok
+}
+
+func (s *Server) findConfigIncludingDeleted(w http.ResponseWriter, r *http.Request) (configKey, *config, bool) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return configKey{}, nil, false
+	}
+
+	key := configKey{branchID: b.ID, componentID: r.PathValue("component"), configID: r.PathValue("config")}
+	c, ok := s.configs[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "notFound", "Configuration %s not found", key.configID)
+
+		return configKey{}, nil, false
+	}
+
+	return key, c, true
+}
+
+func (s *Server) findConfigRow(w http.ResponseWriter, r *http.Request) (*config, *configRow, bool) {
+	c, ok := s.findConfig(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	id := r.PathValue("row")
+	for _, row := range c.Rows {
+		if row.ID == id {
+			return c, row, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "notFound", "Row %s not found", id)
+
+	return nil, nil, false
+}
+
+// applyConfigValues sets the fields present in the request parameters.
+func applyConfigValues(c *config, values url.Values) error {
+	if err := applyCommonValues(
+		values, &c.Name, &c.Description, &c.ChangeDescription, &c.IsDisabled, &c.Configuration, &c.State,
+	); err != nil {
+		return err
+	}
+
+	if order := listValue(values, "rowsSortOrder"); order != nil {
+		c.RowsSortOrder = order
+	}
+
+	return nil
+}
+
+// applyRowValues sets the fields present in the request parameters.
+func applyRowValues(row *configRow, values url.Values) error {
+	return applyCommonValues(
+		values, &row.Name, &row.Description, &row.ChangeDescription, &row.IsDisabled, &row.Configuration, &row.State,
+	)
+}
+
+func applyCommonValues(
+	values url.Values,
+	name, description, changeDescription *string,
+	isDisabled *bool,
+	configuration, state *json.RawMessage,
+) error {
+	if values.Has("name") {
+		*name = values.Get("name")
+	}
+
+	if values.Has("description") {
+		*description = values.Get("description")
+	}
+
+	if values.Has("changeDescription") {
+		*changeDescription = values.Get("changeDescription")
+	}
+
+	if values.Has("isDisabled") {
+		*isDisabled = boolValue(values, "isDisabled")
+	}
+
+	if values.Has("configuration") {
+		object, err := jsonObject(values.Get("configuration"))
+		if err != nil {
+			return err
+		}
+		*configuration = object
+	}
+
+	if values.Has("state") {
+		object, err := jsonObject(values.Get("state"))
+		if err != nil {
+			return err
+		}
+		*state = object
+	}
+
+	return nil
+}
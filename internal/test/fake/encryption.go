@@ -0,0 +1,88 @@
+package fake
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// encryptedPrefix marks values encrypted for the project, real ciphers also contain the component ID.
+const encryptedPrefix = "KBC::ProjectSecure::"
+
+// encrypt encrypts the values of the keys starting with "#" in a JSON body, or the whole plain text body.
+// The fake cipher is reversible, it only proves the value went through the Encryption API.
+func (s *Server) encrypt(w http.ResponseWriter, r *http.Request, _ *token) {
+	query := r.URL.Query()
+	if !slices.Contains(Components, query.Get("componentId")) {
+		writeError(w, http.StatusBadRequest, http.StatusBadRequest, "Component %q not found", query.Get("componentId"))
+
+		return
+	}
+
+	if projectID := query.Get("projectId"); projectID != "" && projectID != strconv.Itoa(ProjectID) {
+		writeError(w, http.StatusBadRequest, http.StatusBadRequest, "Project %s not found", projectID)
+
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, http.StatusBadRequest, "%s", err)
+
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(encryptValue(string(body))))
+
+		return
+	}
+
+	var document any
+	if err := json.Unmarshal(body, &document); err != nil {
+		writeError(w, http.StatusBadRequest, http.StatusBadRequest, "Invalid JSON: %s", err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, encryptDocument(document, false))
+}
+
+// encryptDocument encrypts the string values of the keys starting with "#", including nested values.
+func encryptDocument(value any, secret bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = encryptDocument(item, secret || strings.HasPrefix(key, "#"))
+		}
+
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = encryptDocument(item, secret)
+		}
+
+		return v
+	case string:
+		if secret {
+			return encryptValue(v)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func encryptValue(value string) string {
+	if strings.HasPrefix(value, "KBC::") {
+		return value
+	}
+
+	return encryptedPrefix + base64.StdEncoding.EncodeToString([]byte(value))
+}
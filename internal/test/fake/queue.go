@@ -0,0 +1,153 @@
+package fake
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// queueJob is a job of the Job Queue API running a component configuration.
+// The job is created waiting and it succeeds when it is read for the first time.
+type queueJob struct {
+	ID              string         `json:"id"`
+	BranchID        string         `json:"branchId"`
+	Component       string         `json:"component"`
+	Config          string         `json:"config"`
+	ConfigRowIDs    []string       `json:"configRowIds"`
+	Mode            string         `json:"mode"`
+	IsFinished      bool           `json:"isFinished"`
+	URL             string         `json:"url"`
+	Result          queueJobResult `json:"result"`
+	Status          string         `json:"status"`
+	CreatedTime     string         `json:"createdTime"`
+	StartTime       *string        `json:"startTime"`
+	EndTime         *string        `json:"endTime"`
+	DurationSeconds *int           `json:"durationSeconds"`
+}
+
+type queueJobResult struct {
+	Message string `json:"message"`
+}
+
+// createQueueJob creates a job of an existing configuration and its rows.
+func (s *Server) createQueueJob(w http.ResponseWriter, r *http.Request, _ *token) {
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, http.StatusBadRequest, "%s", err)
+
+		return
+	}
+
+	branchID := DefaultBranchID
+	if value := values.Get("branchId"); value != "" {
+		branchID, _ = strconv.Atoi(value)
+	}
+
+	key := configKey{branchID: branchID, componentID: values.Get("component"), configID: values.Get("config")}
+	c, ok := s.configs[key]
+	if !ok || c.IsDeleted {
+		writeError(
+			w, http.StatusBadRequest, http.StatusBadRequest, "Configuration %q of %s not found", key.configID, key.componentID,
+		)
+
+		return
+	}
+
+	rowIDs := listValue(values, "configRowIds")
+	for _, rowID := range rowIDs {
+		if !slices.ContainsFunc(c.Rows, func(row *configRow) bool { return row.ID == rowID }) {
+			writeError(w, http.StatusBadRequest, http.StatusBadRequest, "Row %q of configuration %q not found", rowID, c.ID)
+
+			return
+		}
+	}
+
+	id := s.nextID()
+	job := &queueJob{
+		ID:           id,
+		BranchID:     strconv.Itoa(branchID),
+		Component:    key.componentID,
+		Config:       key.configID,
+		ConfigRowIDs: rowIDs,
+		Mode:         "run",
+		URL:          s.URL + "/jobs/" + id,
+		Status:       "created",
+		CreatedTime:  now(),
+	}
+	s.queueJobs[id] = job
+	writeJSON(w, http.StatusCreated, job)
+}
+
+func (s *Server) getQueueJob(w http.ResponseWriter, r *http.Request, _ *token) {
+	job, ok := s.queueJobs[r.PathValue("job")]
+	if !ok {
+		writeError(w, http.StatusNotFound, http.StatusNotFound, "Job %s not found", r.PathValue("job"))
+
+		return
+	}
+
+	if !job.IsFinished {
+		finished := now()
+		duration := 0
+		job.Status = "success"
+		job.IsFinished = true
+		job.StartTime = &finished
+		job.EndTime = &finished
+		job.DurationSeconds = &duration
+		job.Result = queueJobResult{Message: "Component processing finished."}
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+// searchQueueJobs returns the jobs matching the query, the newest first.
+func (s *Server) searchQueueJobs(w http.ResponseWriter, r *http.Request, _ *token) {
+	query := r.URL.Query()
+
+	var from, to time.Time
+	for key, value := range map[string]*time.Time{"createdTimeFrom": &from, "createdTimeTo": &to} {
+		if query.Get(key) == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, query.Get(key))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, http.StatusBadRequest, "Invalid %s: %s", key, err)
+
+			return
+		}
+		*value = parsed
+	}
+
+	statuses := listValue(query, "status")
+	jobs := make([]*queueJob, 0)
+	for _, job := range s.queueJobs {
+		created, _ := time.Parse(timeFormat, job.CreatedTime)
+		switch {
+		case query.Get("branchId") != "" && job.BranchID != query.Get("branchId"):
+		case query.Get("componentId") != "" && job.Component != query.Get("componentId"):
+		case query.Get("configId") != "" && job.Config != query.Get("configId"):
+		case len(statuses) > 0 && !slices.Contains(statuses, job.Status):
+		case !from.IsZero() && created.Before(from):
+		case !to.IsZero() && !created.Before(to):
+		default:
+			jobs = append(jobs, job)
+		}
+	}
+
+	// The IDs increase, so they order the jobs created within the same second
+	slices.SortFunc(jobs, func(a, b *queueJob) int {
+		aID, _ := strconv.Atoi(a.ID)
+		bID, _ := strconv.Atoi(b.ID)
+
+		return cmp.Compare(bID, aID)
+	})
+
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 && len(jobs) > limit {
+		jobs = jobs[:limit]
+	}
+
+	writeJSON(w, http.StatusOK, jobs)
+}
@@ -0,0 +1,134 @@
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// schedulerComponentID is the component of the configurations read by the Scheduler API.
+const schedulerComponentID = "keboola.scheduler"
+
+// schedule is an activated keboola.scheduler configuration.
+type schedule struct {
+	ID                     string             `json:"id"`
+	TokenID                string             `json:"tokenId"`
+	ConfigurationID        string             `json:"configurationId"`
+	ConfigurationVersionID string             `json:"configurationVersionId"`
+	Schedule               scheduleDefinition `json:"schedule"`
+	Target                 scheduleTarget     `json:"target"`
+	Executions             []any              `json:"executions"`
+}
+
+type scheduleDefinition struct {
+	CronTab  string `json:"cronTab"`
+	Timezone string `json:"timezone"`
+	State    string `json:"state"`
+}
+
+type scheduleTarget struct {
+	ComponentID     string `json:"componentId"`
+	ConfigurationID string `json:"configurationId"`
+	Mode            string `json:"mode"`
+}
+
+func (s *Server) listSchedules(w http.ResponseWriter, r *http.Request, _ *token) {
+	configID := r.URL.Query().Get("configurationId")
+	schedules := make([]*schedule, 0, len(s.schedules))
+	for _, item := range s.schedules {
+		if configID == "" || item.ConfigurationID == configID {
+			schedules = append(schedules, item)
+		}
+	}
+	slices.SortFunc(schedules, func(a, b *schedule) int { return strings.Compare(a.ID, b.ID) })
+
+	writeJSON(w, http.StatusOK, schedules)
+}
+
+// activateSchedule creates a schedule from a keboola.scheduler configuration in the default branch.
+// An existing schedule of the configuration is replaced.
+func (s *Server) activateSchedule(w http.ResponseWriter, r *http.Request, t *token) {
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, http.StatusBadRequest, "%s", err)
+
+		return
+	}
+
+	configID := values.Get("configurationId")
+	key := configKey{branchID: DefaultBranchID, componentID: schedulerComponentID, configID: configID}
+	c, ok := s.configs[key]
+	if !ok || c.IsDeleted {
+		writeError(
+			w, http.StatusBadRequest, http.StatusBadRequest, "Configuration %q of %s not found", configID, key.componentID,
+		)
+
+		return
+	}
+
+	var content struct {
+		Schedule scheduleDefinition `json:"schedule"`
+		Target   scheduleTarget     `json:"target"`
+	}
+	if err := json.Unmarshal(c.Configuration, &content); err != nil {
+		writeError(w, http.StatusBadRequest, http.StatusBadRequest, "Invalid configuration %q: %s", configID, err)
+
+		return
+	}
+
+	if content.Schedule.State == "" {
+		content.Schedule.State = "enabled"
+	}
+
+	versionID := values.Get("configurationVersionId")
+	if versionID == "" {
+		versionID = strconv.Itoa(c.Version)
+	}
+
+	for id, item := range s.schedules {
+		if item.ConfigurationID == configID {
+			delete(s.schedules, id)
+		}
+	}
+
+	created := &schedule{
+		ID:                     s.nextID(),
+		TokenID:                t.ID,
+		ConfigurationID:        configID,
+		ConfigurationVersionID: versionID,
+		Schedule:               content.Schedule,
+		Target:                 content.Target,
+		Executions:             []any{},
+	}
+	s.schedules[created.ID] = created
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) getSchedule(w http.ResponseWriter, r *http.Request, _ *token) {
+	if item, ok := s.findSchedule(w, r); ok {
+		writeJSON(w, http.StatusOK, item)
+	}
+}
+
+func (s *Server) deleteSchedule(w http.ResponseWriter, r *http.Request, _ *token) {
+	item, ok := s.findSchedule(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.schedules, item.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) findSchedule(w http.ResponseWriter, r *http.Request) (*schedule, bool) {
+	item, ok := s.schedules[r.PathValue("schedule")]
+	if !ok {
+		writeError(w, http.StatusNotFound, http.StatusNotFound, "Schedule %s not found", r.PathValue("schedule"))
+
+		return nil, false
+	}
+
+	return item, true
+}
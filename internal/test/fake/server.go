@@ -0,0 +1,362 @@
+// Package fake provides an in-memory fake of the Keboola Storage, Encryption, Scheduler and Job Queue APIs,
+// so acceptance tests can run without a Keboola project and without network access.
+//
+// Only the endpoints used by the provider are implemented,
+// with the subset of the API behavior the provider relies on.
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Token is the master token of the fake project.
+	Token = "fake-master-token"
+	// ProjectID is the ID of the fake project.
+	ProjectID = 1
+	// DefaultBranchID is the ID of the default branch of the fake project.
+	DefaultBranchID = 1
+
+	tokenHeader = "X-StorageApi-Token" //nolint: gosec
+	timeFormat  = "2006-01-02T15:04:05-0700"
+)
+
+// Components are the IDs of the components available in the fake project.
+var Components = []string{ //nolint: gochecknoglobals
+	"ex-generic-v2",
+	"keboola.orchestrator",
+	"keboola.scheduler",
+	"keboola.snowflake-transformation",
+}
+
+// Server is an in-memory fake of the Keboola APIs served on a local address.
+// Storage, Encryption, Scheduler and Job Queue share the address, the Storage API index points all services to it.
+type Server struct {
+	*httptest.Server
+
+	mu              sync.Mutex
+	lastID          int
+	branches        map[int]*branch
+	metadata        map[int][]*metadataItem
+	storageMetadata map[objectKey][]*metadataItem
+	configs         map[configKey]*config
+	jobs            map[int]*storageJob
+	tokens          map[string]*token
+	schedules       map[string]*schedule
+	queueJobs       map[string]*queueJob
+}
+
+// NewServer starts a fake server with a project containing the default branch and the master token.
+// The server must be closed by the caller.
+func NewServer() *Server {
+	s := &Server{
+		lastID:          100,
+		branches:        make(map[int]*branch),
+		metadata:        make(map[int][]*metadataItem),
+		storageMetadata: make(map[objectKey][]*metadataItem),
+		configs:         make(map[configKey]*config),
+		jobs:            make(map[int]*storageJob),
+		tokens:          make(map[string]*token),
+		schedules:       make(map[string]*schedule),
+		queueJobs:       make(map[string]*queueJob),
+	}
+
+	s.branches[DefaultBranchID] = &branch{ID: DefaultBranchID, Name: "Main", Created: now(), IsDefault: true}
+	master := newToken(s.nextID(), "Master token")
+	master.Token = Token
+	master.IsMasterToken = true
+	master.CanManageBuckets = true
+	master.CanManageTokens = true
+	master.CanReadAllFileUploads = true
+	master.CanPurgeTrash = true
+	master.Admin = &tokenAdmin{ID: 1, Name: "Fake Admin", Role: "admin"}
+	s.tokens[master.ID] = master
+
+	s.Server = httptest.NewServer(s.routes())
+
+	return s
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	// Storage API
+	mux.HandleFunc("GET /v2/storage", s.public(s.index))
+	mux.HandleFunc("GET /v2/storage/{$}", s.public(s.index))
+	mux.HandleFunc("GET /v2/storage/tokens/verify", s.authorized(s.verifyToken))
+	mux.HandleFunc("GET /v2/storage/tokens", s.authorized(s.listTokens))
+	mux.HandleFunc("POST /v2/storage/tokens", s.authorized(s.createToken))
+	mux.HandleFunc("GET /v2/storage/tokens/{token}", s.authorized(s.getToken))
+	mux.HandleFunc("POST /v2/storage/tokens/{token}/refresh", s.authorized(s.refreshToken))
+	mux.HandleFunc("DELETE /v2/storage/tokens/{token}", s.authorized(s.deleteToken))
+	mux.HandleFunc("GET /v2/storage/jobs/{job}", s.authorized(s.getJob))
+	mux.HandleFunc("GET /v2/storage/dev-branches", s.authorized(s.listBranches))
+	mux.HandleFunc("POST /v2/storage/dev-branches", s.authorized(s.createBranch))
+	mux.HandleFunc("GET /v2/storage/dev-branches/{branch}", s.authorized(s.getBranch))
+	mux.HandleFunc("PUT /v2/storage/dev-branches/{branch}", s.authorized(s.updateBranch))
+	mux.HandleFunc("DELETE /v2/storage/dev-branches/{branch}", s.authorized(s.deleteBranch))
+	mux.HandleFunc("GET /v2/storage/branch/{branch}/metadata", s.authorized(s.listBranchMetadata))
+	mux.HandleFunc("POST /v2/storage/branch/{branch}/metadata", s.authorized(s.appendBranchMetadata))
+	mux.HandleFunc("DELETE /v2/storage/branch/{branch}/metadata/{metadata}", s.authorized(s.deleteBranchMetadata))
+
+	bucket := "/v2/storage/branch/{branch}/buckets/{bucket}/metadata"
+	mux.HandleFunc("GET "+bucket, s.authorized(s.listBucketMetadata))
+	mux.HandleFunc("POST "+bucket, s.authorized(s.appendBucketMetadata))
+	mux.HandleFunc("DELETE "+bucket+"/{metadata}", s.authorized(s.deleteBucketMetadata))
+	table := "/v2/storage/branch/{branch}/tables/{table}/metadata"
+	mux.HandleFunc("GET "+table, s.authorized(s.listTableMetadata))
+	mux.HandleFunc("POST "+table, s.authorized(s.appendTableMetadata))
+	mux.HandleFunc("DELETE "+table+"/{metadata}", s.authorized(s.deleteTableMetadata))
+	column := "/v2/storage/branch/{branch}/columns/{column}/metadata"
+	mux.HandleFunc("GET "+column, s.authorized(s.listColumnMetadata))
+	mux.HandleFunc("POST "+column, s.authorized(s.appendColumnMetadata))
+	mux.HandleFunc("DELETE "+column+"/{metadata}", s.authorized(s.deleteColumnMetadata))
+
+	mux.HandleFunc("GET /v2/storage/branch/{branch}/components", s.authorized(s.listComponents))
+
+	configs := "/v2/storage/branch/{branch}/components/{component}/configs"
+	mux.HandleFunc("GET "+configs, s.authorized(s.listConfigs))
+	mux.HandleFunc("POST "+configs, s.authorized(s.createConfig))
+	mux.HandleFunc("GET "+configs+"/{config}", s.authorized(s.getConfig))
+	mux.HandleFunc("PUT "+configs+"/{config}", s.authorized(s.updateConfig))
+	mux.HandleFunc("DELETE "+configs+"/{config}", s.authorized(s.deleteConfig))
+	mux.HandleFunc("POST "+configs+"/{config}/restore", s.authorized(s.restoreConfig))
+	mux.HandleFunc("GET "+configs+"/{config}/rows", s.authorized(s.listConfigRows))
+	mux.HandleFunc("POST "+configs+"/{config}/rows", s.authorized(s.createConfigRow))
+	mux.HandleFunc("GET "+configs+"/{config}/rows/{row}", s.authorized(s.getConfigRow))
+	mux.HandleFunc("PUT "+configs+"/{config}/rows/{row}", s.authorized(s.updateConfigRow))
+	mux.HandleFunc("DELETE "+configs+"/{config}/rows/{row}", s.authorized(s.deleteConfigRow))
+
+	// Encryption API
+	mux.HandleFunc("POST /encrypt", s.public(s.encrypt))
+
+	// Scheduler API
+	mux.HandleFunc("GET /schedules", s.authorized(s.listSchedules))
+	mux.HandleFunc("POST /schedules", s.authorized(s.activateSchedule))
+	mux.HandleFunc("GET /schedules/{schedule}", s.authorized(s.getSchedule))
+	mux.HandleFunc("DELETE /schedules/{schedule}", s.authorized(s.deleteSchedule))
+
+	// Job Queue API
+	mux.HandleFunc("POST /jobs", s.authorized(s.createQueueJob))
+	mux.HandleFunc("GET /jobs/{job}", s.authorized(s.getQueueJob))
+	mux.HandleFunc("GET /search/jobs", s.authorized(s.searchQueueJobs))
+
+	return mux
+}
+
+// handlerFunc handles a request of the given token, the state of the server is locked.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, t *token)
+
+// public wraps a handler of an endpoint which does not need a token.
+func (s *Server) public(h handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		h(w, r, nil)
+	}
+}
+
+// authorized wraps a handler of an endpoint which needs a valid token.
+func (s *Server) authorized(h handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		value := r.Header.Get(tokenHeader)
+		for _, t := range s.tokens {
+			if value != "" && t.Token == value && !t.IsDisabled {
+				h(w, r, t)
+
+				return
+			}
+		}
+
+		writeError(w, http.StatusUnauthorized, "storage.tokenInvalid", "Invalid access token")
+	}
+}
+
+func (s *Server) nextID() string {
+	s.lastID++
+
+	return strconv.Itoa(s.lastID)
+}
+
+func now() string {
+	return time.Now().Format(timeFormat)
+}
+
+// apiError is the error body of the Keboola APIs.
+// The code is a string in the Storage API and the HTTP status in the other APIs.
+type apiError struct {
+	Error       string `json:"error"`
+	Code        any    `json:"code"`
+	Status      string `json:"status"`
+	ExceptionID string `json:"exceptionId"`
+}
+
+func writeError(w http.ResponseWriter, status int, code any, format string, args ...any) {
+	writeJSON(w, status, apiError{
+		Error:       fmt.Sprintf(format, args...),
+		Code:        code,
+		Status:      "error",
+		ExceptionID: "fake-" + strconv.FormatInt(time.Now().UnixNano(), 36),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// readValues returns the request parameters, sent either as a form or as a JSON object.
+// Nested JSON values are flattened to form keys, e.g. metadata[0][key] or componentAccess[],
+// JSON objects are also kept whole under their key, e.g. configuration.
+func readValues(r *http.Request) (url.Values, error) {
+	values := url.Values{}
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("invalid form: %w", err)
+		}
+
+		return r.Form, nil
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid JSON body: %w", err)
+	}
+
+	for key, raw := range body {
+		if err := flattenJSON(values, key, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+func flattenJSON(values url.Values, key string, raw json.RawMessage) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	switch raw[0] {
+	case '{':
+		values.Set(key, string(raw))
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return fmt.Errorf("invalid JSON value of %s: %w", key, err)
+		}
+
+		for field, value := range object {
+			if err := flattenJSON(values, key+"["+field+"]", value); err != nil {
+				return err
+			}
+		}
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return fmt.Errorf("invalid JSON value of %s: %w", key, err)
+		}
+
+		for i, item := range items {
+			item = bytes.TrimSpace(item)
+			if len(item) > 0 && (item[0] == '{' || item[0] == '[') {
+				if err := flattenJSON(values, key+"["+strconv.Itoa(i)+"]", item); err != nil {
+					return err
+				}
+			} else if err := flattenJSON(values, key+"[]", item); err != nil {
+				return err
+			}
+		}
+	case '"':
+		var str string
+		if err := json.Unmarshal(raw, &str); err != nil {
+			return fmt.Errorf("invalid JSON value of %s: %w", key, err)
+		}
+
+		values.Add(key, str)
+	default:
+		// Numbers and booleans
+		values.Add(key, string(raw))
+	}
+
+	return nil
+}
+
+// listValue returns the items of a list parameter, e.g. componentAccess[]=a&componentAccess[]=b.
+func listValue(values url.Values, key string) []string {
+	if items, ok := values[key+"[]"]; ok {
+		return items
+	}
+
+	return values[key]
+}
+
+// boolValue returns the boolean parameter, forms send true as "1" or "true".
+func boolValue(values url.Values, key string) bool {
+	value := values.Get(key)
+
+	return value == "1" || value == "true"
+}
+
+var indexedKeyRegexp = regexp.MustCompile(`^(.+)\[(\d+)]\[([^\]]+)]$`)
+
+// indexedValues returns the items of a list of objects parameter, e.g. metadata[0][key]=a&metadata[0][value]=b.
+func indexedValues(values url.Values, key string) []map[string]string {
+	byIndex := make(map[int]map[string]string)
+	for k := range values {
+		match := indexedKeyRegexp.FindStringSubmatch(k)
+		if match == nil || match[1] != key {
+			continue
+		}
+
+		index, _ := strconv.Atoi(match[2])
+		if byIndex[index] == nil {
+			byIndex[index] = make(map[string]string)
+		}
+		byIndex[index][match[3]] = values.Get(k)
+	}
+
+	indexes := make([]int, 0, len(byIndex))
+	for index := range byIndex {
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+
+	items := make([]map[string]string, 0, len(indexes))
+	for _, index := range indexes {
+		items = append(items, byIndex[index])
+	}
+
+	return items
+}
+
+// jsonObject validates that the value is a JSON object, the empty value results in an empty object.
+func jsonObject(value string) (json.RawMessage, error) {
+	if strings.TrimSpace(value) == "" {
+		return json.RawMessage("{}"), nil
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &object); err != nil {
+		return nil, fmt.Errorf("value is not a JSON object: %w", err)
+	}
+
+	return json.RawMessage(value), nil
+}
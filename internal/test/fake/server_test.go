@@ -0,0 +1,327 @@
+package fake_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/test/fake"
+)
+
+type client struct {
+	t      *testing.T
+	server *fake.Server
+	token  string
+}
+
+func (c *client) do(method, path string, form url.Values, result any) int {
+	c.t.Helper()
+
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(c.t.Context(), method, c.server.URL+path, body)
+	require.NoError(c.t, err)
+	req.Header.Set("X-StorageApi-Token", c.token)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.server.Client().Do(req)
+	require.NoError(c.t, err)
+	defer resp.Body.Close()
+
+	if result != nil && resp.StatusCode < http.StatusMultipleChoices {
+		require.NoError(c.t, json.NewDecoder(resp.Body).Decode(result))
+	}
+
+	return resp.StatusCode
+}
+
+func newClient(t *testing.T) *client {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	return &client{t: t, server: server, token: fake.Token}
+}
+
+func TestFakeServerAuthorization(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t)
+
+	var index struct {
+		Services []struct {
+			ID  string `json:"id"`
+			URL string `json:"url"`
+		} `json:"services"`
+		Components []struct {
+			ID string `json:"id"`
+		} `json:"components"`
+	}
+	assert.Equal(t, http.StatusOK, c.do(http.MethodGet, "/v2/storage/", nil, &index))
+	assert.Len(t, index.Components, len(fake.Components))
+	for _, service := range index.Services {
+		assert.Equal(t, c.server.URL, service.URL)
+	}
+
+	var token struct {
+		IsMasterToken bool `json:"isMasterToken"`
+	}
+	assert.Equal(t, http.StatusOK, c.do(http.MethodGet, "/v2/storage/tokens/verify", nil, &token))
+	assert.True(t, token.IsMasterToken)
+
+	c.token = "invalid"
+	assert.Equal(t, http.StatusUnauthorized, c.do(http.MethodGet, "/v2/storage/tokens/verify", nil, nil))
+}
+
+func TestFakeServerConfigLifecycle(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t)
+	configs := "/v2/storage/branch/default/components/ex-generic-v2/configs"
+
+	type config struct {
+		ID            string          `json:"id"`
+		Version       int             `json:"version"`
+		IsDisabled    bool            `json:"isDisabled"`
+		Configuration json.RawMessage `json:"configuration"`
+		Rows          []struct {
+			ID string `json:"id"`
+		} `json:"rows"`
+	}
+
+	var created config
+	status := c.do(http.MethodPost, configs, url.Values{
+		"configurationId": {"my-config"},
+		"name":            {"My config"},
+		"configuration":   {`{"b":1,"a":2}`},
+	}, &created)
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "my-config", created.ID)
+	assert.Equal(t, 1, created.Version)
+	assert.JSONEq(t, `{"b":1,"a":2}`, string(created.Configuration))
+
+	// Invalid content is refused
+	status = c.do(http.MethodPut, configs+"/my-config", url.Values{"configuration": {`[1]`}}, nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// Each change, including rows, increments the version
+	var updated config
+	status = c.do(http.MethodPut, configs+"/my-config", url.Values{"isDisabled": {"1"}}, &updated)
+	require.Equal(t, http.StatusOK, status)
+	assert.True(t, updated.IsDisabled)
+	assert.Equal(t, 2, updated.Version)
+
+	status = c.do(http.MethodPost, configs+"/my-config/rows", url.Values{"name": {"Row"}}, nil)
+	require.Equal(t, http.StatusCreated, status)
+
+	var read config
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, configs+"/my-config", nil, &read))
+	assert.Equal(t, 3, read.Version)
+	assert.Len(t, read.Rows, 1)
+
+	// The configurations of the branch are listed with their rows, grouped by the component
+	var components []struct {
+		ID             string   `json:"id"`
+		Configurations []config `json:"configurations"`
+	}
+	listComponents := "/v2/storage/branch/default/components?include=configuration,rows"
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, listComponents, nil, &components))
+	require.Len(t, components, 1)
+	assert.Equal(t, "ex-generic-v2", components[0].ID)
+	require.Len(t, components[0].Configurations, 1)
+	assert.Len(t, components[0].Configurations[0].Rows, 1)
+
+	// The first delete moves the configuration to the trash
+	assert.Equal(t, http.StatusNoContent, c.do(http.MethodDelete, configs+"/my-config", nil, nil))
+	assert.Equal(t, http.StatusNotFound, c.do(http.MethodGet, configs+"/my-config", nil, nil))
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, listComponents, nil, &components))
+	assert.Empty(t, components)
+	status = c.do(http.MethodPost, configs, url.Values{"configurationId": {"my-config"}, "name": {"Again"}}, nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, http.StatusOK, c.do(http.MethodPost, configs+"/my-config/restore", nil, nil))
+	assert.Equal(t, http.StatusOK, c.do(http.MethodGet, configs+"/my-config", nil, nil))
+
+	// The second delete purges it
+	assert.Equal(t, http.StatusNoContent, c.do(http.MethodDelete, configs+"/my-config", nil, nil))
+	assert.Equal(t, http.StatusNoContent, c.do(http.MethodDelete, configs+"/my-config", nil, nil))
+	assert.Equal(t, http.StatusNotFound, c.do(http.MethodPost, configs+"/my-config/restore", nil, nil))
+}
+
+func TestFakeServerBranchJob(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t)
+
+	type job struct {
+		ID      int    `json:"id"`
+		Status  string `json:"status"`
+		Results *struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"results"`
+	}
+
+	var created job
+	status := c.do(http.MethodPost, "/v2/storage/dev-branches", url.Values{"name": {"feature"}}, &created)
+	require.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, "waiting", created.Status)
+	assert.Nil(t, created.Results)
+
+	var finished job
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/v2/storage/jobs/"+strconv.Itoa(created.ID), nil, &finished))
+	assert.Equal(t, "success", finished.Status)
+	require.NotNil(t, finished.Results)
+	assert.Equal(t, "feature", finished.Results.Name)
+
+	// Branch metadata is upserted by key
+	metadata := "/v2/storage/branch/" + strconv.Itoa(finished.Results.ID) + "/metadata"
+	form := url.Values{"metadata[0][key]": {"KBC.description"}, "metadata[0][value]": {"first"}}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, metadata, form, nil))
+	form.Set("metadata[0][value]", "second")
+
+	var items []struct {
+		Value string `json:"value"`
+	}
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, metadata, form, &items))
+	require.Len(t, items, 1)
+	assert.Equal(t, "second", items[0].Value)
+
+	// The default branch cannot be deleted
+	assert.Equal(t, http.StatusBadRequest, c.do(http.MethodDelete, "/v2/storage/dev-branches/1", nil, nil))
+	assert.Equal(t, http.StatusAccepted, c.do(http.MethodDelete, "/v2/storage/dev-branches/"+strconv.Itoa(finished.Results.ID), nil, nil))
+	assert.Equal(t, http.StatusNotFound, c.do(http.MethodGet, metadata, nil, nil))
+}
+
+func TestFakeServerStorageMetadata(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t)
+
+	type item struct {
+		ID       string `json:"id"`
+		Key      string `json:"key"`
+		Value    string `json:"value"`
+		Provider string `json:"provider"`
+	}
+
+	targets := []string{
+		"/v2/storage/branch/1/buckets/" + fake.BucketID + "/metadata",
+		"/v2/storage/branch/default/tables/" + fake.TableID + "/metadata",
+		"/v2/storage/branch/1/columns/" + fake.TableID + "." + fake.TableColumns[0] + "/metadata",
+	}
+	for _, target := range targets {
+		// Metadata are upserted by the provider and the key
+		form := url.Values{"provider": {"user"}, "metadata[0][key]": {"KBC.description"}, "metadata[0][value]": {"a"}}
+		require.Equal(t, http.StatusCreated, c.do(http.MethodPost, target, form, nil), target)
+		form.Set("metadata[0][value]", "b")
+		require.Equal(t, http.StatusCreated, c.do(http.MethodPost, target, form, nil), target)
+		form.Set("provider", "other")
+
+		var items []item
+		require.Equal(t, http.StatusCreated, c.do(http.MethodPost, target, form, &items), target)
+		require.Len(t, items, 2, target)
+		assert.Equal(t, item{ID: items[0].ID, Key: "KBC.description", Value: "b", Provider: "user"}, items[0])
+
+		assert.Equal(t, http.StatusNoContent, c.do(http.MethodDelete, target+"/"+items[0].ID, nil, nil), target)
+		assert.Equal(t, http.StatusNotFound, c.do(http.MethodDelete, target+"/"+items[0].ID, nil, nil), target)
+		require.Equal(t, http.StatusOK, c.do(http.MethodGet, target, nil, &items), target)
+		assert.Len(t, items, 1, target)
+	}
+
+	// The provider is required and only the objects of the fake project exist
+	form := url.Values{"metadata[0][key]": {"KBC.description"}, "metadata[0][value]": {"a"}}
+	assert.Equal(t, http.StatusBadRequest, c.do(http.MethodPost, targets[0], form, nil))
+	assert.Equal(t, http.StatusNotFound, c.do(http.MethodGet, "/v2/storage/branch/1/buckets/in.c-missing/metadata", nil, nil))
+	assert.Equal(t, http.StatusNotFound, c.do(http.MethodGet, "/v2/storage/branch/1/tables/in.c-fake.missing/metadata", nil, nil))
+	assert.Equal(t, http.StatusNotFound, c.do(http.MethodGet, "/v2/storage/branch/1/columns/in.c-fake.users.missing/metadata", nil, nil))
+}
+
+func TestFakeServerQueueJob(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t)
+	configs := "/v2/storage/branch/default/components/ex-generic-v2/configs"
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, configs, url.Values{
+		"configurationId": {"my-config"},
+		"name":            {"My config"},
+	}, nil))
+
+	type job struct {
+		ID         string `json:"id"`
+		Status     string `json:"status"`
+		IsFinished bool   `json:"isFinished"`
+		EndTime    string `json:"endTime"`
+	}
+
+	// The configuration must exist
+	form := url.Values{"component": {"ex-generic-v2"}, "config": {"missing"}}
+	assert.Equal(t, http.StatusBadRequest, c.do(http.MethodPost, "/jobs", form, nil))
+
+	var created job
+	form.Set("config", "my-config")
+	require.Equal(t, http.StatusCreated, c.do(http.MethodPost, "/jobs", form, &created))
+	assert.Equal(t, "created", created.Status)
+	assert.False(t, created.IsFinished)
+
+	var finished job
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/jobs/"+created.ID, nil, &finished))
+	assert.Equal(t, "success", finished.Status)
+	assert.True(t, finished.IsFinished)
+	assert.NotEmpty(t, finished.EndTime)
+
+	assert.Equal(t, http.StatusNotFound, c.do(http.MethodGet, "/jobs/999", nil, nil))
+
+	// Search filters by the configuration and the status
+	var found []job
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/search/jobs?configId=my-config&status=success", nil, &found))
+	require.Len(t, found, 1)
+	assert.Equal(t, created.ID, found[0].ID)
+
+	require.Equal(t, http.StatusOK, c.do(http.MethodGet, "/search/jobs?status=error", nil, &found))
+	assert.Empty(t, found)
+}
+
+func TestFakeServerEncrypt(t *testing.T) {
+	t.Parallel()
+
+	c := newClient(t)
+
+	req, err := http.NewRequestWithContext(
+		t.Context(),
+		http.MethodPost,
+		c.server.URL+"/encrypt?componentId=ex-generic-v2&projectId=1",
+		strings.NewReader(`{"#secret":"value","public":"value","nested":{"#password":"value"}}`),
+	)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result struct {
+		Secret string `json:"#secret"`
+		Public string `json:"public"`
+		Nested struct {
+			Password string `json:"#password"`
+		} `json:"nested"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Regexp(t, `^KBC::ProjectSecure::.+`, result.Secret)
+	assert.Regexp(t, `^KBC::ProjectSecure::.+`, result.Nested.Password)
+	assert.Equal(t, "value", result.Public)
+}
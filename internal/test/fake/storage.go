@@ -0,0 +1,489 @@
+package fake
+
+import (
+	"crypto/rand"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// service is an item of the services list of the Storage API index.
+type service struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// component is an item of the components list of the Storage API index.
+type component struct {
+	ID                     string         `json:"id"`
+	Type                   string         `json:"type"`
+	Name                   string         `json:"name"`
+	Flags                  []string       `json:"flags"`
+	ConfigurationSchema    map[string]any `json:"configurationSchema"`
+	ConfigurationRowSchema map[string]any `json:"configurationRowSchema"`
+	EmptyConfiguration     map[string]any `json:"emptyConfiguration"`
+	EmptyConfigurationRow  map[string]any `json:"emptyConfigurationRow"`
+	Data                   map[string]any `json:"data"`
+}
+
+func (s *Server) index(w http.ResponseWriter, r *http.Request, _ *token) {
+	services := make([]service, 0)
+	for _, id := range []string{"encryption", "scheduler", "queue", "sync-actions"} {
+		services = append(services, service{ID: id, URL: s.URL})
+	}
+
+	body := map[string]any{
+		"api":           "storage",
+		"version":       "v2",
+		"documentation": "https://keboola.docs.apiary.io/",
+		"features":      []string{},
+		"services":      services,
+	}
+
+	if !slices.Contains(strings.Split(r.URL.Query().Get("exclude"), ","), "components") {
+		components := make([]component, 0, len(Components))
+		for _, id := range Components {
+			componentType := "other"
+			switch {
+			case strings.HasPrefix(id, "ex-"):
+				componentType = "extractor"
+			case strings.HasSuffix(id, "-transformation"):
+				componentType = "transformation"
+			}
+
+			components = append(components, component{
+				ID:                     id,
+				Type:                   componentType,
+				Name:                   id,
+				Flags:                  []string{},
+				ConfigurationSchema:    map[string]any{},
+				ConfigurationRowSchema: map[string]any{},
+				EmptyConfiguration:     map[string]any{},
+				EmptyConfigurationRow:  map[string]any{},
+				Data:                   map[string]any{},
+			})
+		}
+		body["components"] = components
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// token is a Storage API token, the secret value is only sent when the token is created or refreshed.
+type token struct {
+	ID                    string            `json:"id"`
+	Token                 string            `json:"token,omitempty"`
+	Description           string            `json:"description"`
+	IsMasterToken         bool              `json:"isMasterToken"`
+	CanManageBuckets      bool              `json:"canManageBuckets"`
+	CanManageTokens       bool              `json:"canManageTokens"`
+	CanReadAllFileUploads bool              `json:"canReadAllFileUploads"`
+	CanPurgeTrash         bool              `json:"canPurgeTrash"`
+	Created               string            `json:"created"`
+	Refreshed             string            `json:"refreshed"`
+	Expires               *string           `json:"expires"`
+	IsExpired             bool              `json:"isExpired"`
+	IsDisabled            bool              `json:"isDisabled"`
+	Owner                 tokenOwner        `json:"owner"`
+	Admin                 *tokenAdmin       `json:"admin,omitempty"`
+	BucketPermissions     map[string]string `json:"bucketPermissions"`
+	ComponentAccess       []string          `json:"componentAccess"`
+}
+
+type tokenOwner struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type tokenAdmin struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+func newToken(id, description string) *token {
+	created := now()
+
+	return &token{
+		ID:                id,
+		Token:             strconv.Itoa(ProjectID) + "-" + id + "-" + rand.Text(),
+		Description:       description,
+		Created:           created,
+		Refreshed:         created,
+		Owner:             tokenOwner{ID: ProjectID, Name: "Fake project"},
+		BucketPermissions: map[string]string{},
+		ComponentAccess:   []string{},
+	}
+}
+
+// withoutSecret returns a copy of the token without the secret value.
+func (t *token) withoutSecret() *token {
+	clone := *t
+	clone.Token = ""
+
+	return &clone
+}
+
+func (s *Server) verifyToken(w http.ResponseWriter, _ *http.Request, t *token) {
+	writeJSON(w, http.StatusOK, t.withoutSecret())
+}
+
+func (s *Server) listTokens(w http.ResponseWriter, _ *http.Request, _ *token) {
+	tokens := make([]*token, 0, len(s.tokens))
+	for _, t := range s.tokens {
+		tokens = append(tokens, t.withoutSecret())
+	}
+	slices.SortFunc(tokens, func(a, b *token) int { return strings.Compare(a.ID, b.ID) })
+
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+var bucketPermissionKeyRegexp = regexp.MustCompile(`^bucketPermissions\[([^\]]+)]$`)
+
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request, t *token) {
+	if !t.CanManageTokens {
+		writeError(w, http.StatusForbidden, "accessDenied", "You don't have access to manage tokens")
+
+		return
+	}
+
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	created := newToken(s.nextID(), values.Get("description"))
+	created.CanManageBuckets = boolValue(values, "canManageBuckets")
+	created.CanReadAllFileUploads = boolValue(values, "canReadAllFileUploads")
+	created.CanPurgeTrash = boolValue(values, "canPurgeTrash")
+	created.ComponentAccess = append(created.ComponentAccess, listValue(values, "componentAccess")...)
+	for key := range values {
+		if match := bucketPermissionKeyRegexp.FindStringSubmatch(key); match != nil {
+			created.BucketPermissions[match[1]] = values.Get(key)
+		}
+	}
+
+	if expiresIn, _ := strconv.Atoi(values.Get("expiresIn")); expiresIn > 0 {
+		expires := time.Now().Add(time.Duration(expiresIn) * time.Second).Format(timeFormat)
+		created.Expires = &expires
+	}
+
+	s.tokens[created.ID] = created
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) getToken(w http.ResponseWriter, r *http.Request, _ *token) {
+	if t, ok := s.findToken(w, r); ok {
+		writeJSON(w, http.StatusOK, t.withoutSecret())
+	}
+}
+
+func (s *Server) refreshToken(w http.ResponseWriter, r *http.Request, _ *token) {
+	t, ok := s.findToken(w, r)
+	if !ok {
+		return
+	}
+
+	t.Token = strconv.Itoa(ProjectID) + "-" + t.ID + "-" + rand.Text()
+	t.Refreshed = now()
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteToken(w http.ResponseWriter, r *http.Request, _ *token) {
+	t, ok := s.findToken(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.tokens, t.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) findToken(w http.ResponseWriter, r *http.Request) (*token, bool) {
+	t, ok := s.tokens[r.PathValue("token")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "storage.tokens.notFound", "Token %s not found", r.PathValue("token"))
+
+		return nil, false
+	}
+
+	return t, true
+}
+
+// storageJob is an asynchronous Storage API operation.
+// The operation is applied immediately, the job finishes when it is polled for the first time.
+type storageJob struct {
+	ID              int              `json:"id"`
+	Status          string           `json:"status"`
+	URL             string           `json:"url"`
+	OperationName   string           `json:"operationName"`
+	OperationParams map[string]any   `json:"operationParams"`
+	Results         any              `json:"results"`
+	CreatedTime     string           `json:"createdTime"`
+	StartTime       *string          `json:"startTime"`
+	EndTime         *string          `json:"endTime"`
+	Error           *storageJobError `json:"error"`
+
+	// result is published in Results when the job finishes
+	result any
+}
+
+type storageJobError struct {
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	ExceptionID string `json:"exceptionId"`
+}
+
+func (s *Server) newJob(operation string, params map[string]any, result any) *storageJob {
+	id, _ := strconv.Atoi(s.nextID())
+	job := &storageJob{
+		ID:              id,
+		Status:          "waiting",
+		URL:             s.URL + "/v2/storage/jobs/" + strconv.Itoa(id),
+		OperationName:   operation,
+		OperationParams: params,
+		CreatedTime:     now(),
+		result:          result,
+	}
+	s.jobs[id] = job
+
+	return job
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request, _ *token) {
+	id, _ := strconv.Atoi(r.PathValue("job"))
+	job, ok := s.jobs[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "storage.jobs.notFound", "Job %s not found", r.PathValue("job"))
+
+		return
+	}
+
+	if job.Status != "success" {
+		finished := now()
+		job.Status = "success"
+		job.StartTime = &finished
+		job.EndTime = &finished
+		job.Results = job.result
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+// branch is a development branch, the default branch cannot be deleted.
+type branch struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Created     string `json:"created"`
+	IsDefault   bool   `json:"isDefault"`
+}
+
+func (s *Server) listBranches(w http.ResponseWriter, _ *http.Request, _ *token) {
+	branches := make([]*branch, 0, len(s.branches))
+	for _, b := range s.branches {
+		branches = append(branches, b)
+	}
+	slices.SortFunc(branches, func(a, b *branch) int { return a.ID - b.ID })
+
+	writeJSON(w, http.StatusOK, branches)
+}
+
+func (s *Server) createBranch(w http.ResponseWriter, r *http.Request, _ *token) {
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	name := values.Get("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "storage.validation", "Branch name must not be empty")
+
+		return
+	}
+
+	for _, b := range s.branches {
+		if strings.EqualFold(b.Name, name) {
+			writeError(w, http.StatusBadRequest, "storage.devBranches.alreadyExists", "Branch %q already exists", name)
+
+			return
+		}
+	}
+
+	id, _ := strconv.Atoi(s.nextID())
+	created := &branch{ID: id, Name: name, Description: values.Get("description"), Created: now()}
+	s.branches[id] = created
+
+	job := s.newJob("devBranchCreate", map[string]any{"values": map[string]any{"name": name}}, created)
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (s *Server) getBranch(w http.ResponseWriter, r *http.Request, _ *token) {
+	if b, ok := s.findBranch(w, r); ok {
+		writeJSON(w, http.StatusOK, b)
+	}
+}
+
+func (s *Server) updateBranch(w http.ResponseWriter, r *http.Request, _ *token) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return
+	}
+
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	if values.Has("name") && values.Get("name") != b.Name {
+		if b.IsDefault {
+			writeError(w, http.StatusBadRequest, "storage.devBranches.validation", "Default branch cannot be renamed")
+
+			return
+		}
+		b.Name = values.Get("name")
+	}
+
+	if values.Has("description") {
+		b.Description = values.Get("description")
+	}
+
+	writeJSON(w, http.StatusOK, b)
+}
+
+func (s *Server) deleteBranch(w http.ResponseWriter, r *http.Request, _ *token) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return
+	}
+
+	if b.IsDefault {
+		writeError(w, http.StatusBadRequest, "storage.devBranches.validation", "Default branch cannot be deleted")
+
+		return
+	}
+
+	delete(s.branches, b.ID)
+	delete(s.metadata, b.ID)
+	for key := range s.storageMetadata {
+		if key.branchID == b.ID {
+			delete(s.storageMetadata, key)
+		}
+	}
+	for key := range s.configs {
+		if key.branchID == b.ID {
+			delete(s.configs, key)
+		}
+	}
+
+	job := s.newJob("devBranchDelete", map[string]any{"devBranchId": b.ID}, nil)
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+// findBranch returns the branch from the path, "default" refers to the default branch.
+func (s *Server) findBranch(w http.ResponseWriter, r *http.Request) (*branch, bool) {
+	value := r.PathValue("branch")
+	id := DefaultBranchID
+	if value != "default" {
+		id, _ = strconv.Atoi(value)
+	}
+
+	b, ok := s.branches[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "storage.devBranches.notFound", "Branch %s not found", value)
+
+		return nil, false
+	}
+
+	return b, true
+}
+
+// metadataItem is a metadata key-value pair of a branch, bucket, table or column.
+type metadataItem struct {
+	ID        string `json:"id"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Provider  string `json:"provider"`
+	Timestamp string `json:"timestamp"`
+}
+
+func (s *Server) listBranchMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	if b, ok := s.findBranch(w, r); ok {
+		writeJSON(w, http.StatusOK, s.branchMetadata(b.ID))
+	}
+}
+
+func (s *Server) appendBranchMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return
+	}
+
+	values, err := readValues(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "storage.validation", "%s", err)
+
+		return
+	}
+
+	for _, item := range indexedValues(values, "metadata") {
+		if item["key"] == "" {
+			writeError(w, http.StatusBadRequest, "storage.metadata.validation", "Metadata key must not be empty")
+
+			return
+		}
+
+		existing := slices.IndexFunc(s.metadata[b.ID], func(m *metadataItem) bool { return m.Key == item["key"] })
+		if existing >= 0 {
+			s.metadata[b.ID][existing].Value = item["value"]
+			s.metadata[b.ID][existing].Timestamp = now()
+
+			continue
+		}
+
+		s.metadata[b.ID] = append(s.metadata[b.ID], &metadataItem{
+			ID:        s.nextID(),
+			Key:       item["key"],
+			Value:     item["value"],
+			Provider:  "user",
+			Timestamp: now(),
+		})
+	}
+
+	writeJSON(w, http.StatusCreated, s.branchMetadata(b.ID))
+}
+
+func (s *Server) deleteBranchMetadata(w http.ResponseWriter, r *http.Request, _ *token) {
+	b, ok := s.findBranch(w, r)
+	if !ok {
+		return
+	}
+
+	id := r.PathValue("metadata")
+	index := slices.IndexFunc(s.metadata[b.ID], func(m *metadataItem) bool { return m.ID == id })
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "storage.metadata.notFound", "Metadata %s not found", id)
+
+		return
+	}
+
+	s.metadata[b.ID] = slices.Delete(s.metadata[b.ID], index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) branchMetadata(branchID int) []*metadataItem {
+	if items := s.metadata[branchID]; items != nil {
+		return items
+	}
+
+	return []*metadataItem{}
+}
@@ -0,0 +1,117 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/functions"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
+)
+
+// run calls the function with the arguments, the result has the type of the zero value.
+func run(t *testing.T, f function.Function, zero attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	resp := &function.RunResponse{Result: function.NewResultData(zero)}
+	f.Run(t.Context(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseConfigID(t *testing.T) {
+	t.Parallel()
+
+	zero := types.ObjectUnknown(map[string]attr.Type{
+		"branch_id":        types.Int64Type,
+		"component_id":     types.StringType,
+		"configuration_id": types.StringType,
+	})
+
+	result, funcErr := run(t, functions.NewParseConfigID(), zero, types.StringValue("123/ex-generic-v2/456"))
+	require.Nil(t, funcErr)
+	attributes := result.(types.Object).Attributes() //nolint: forcetypeassert
+	assert.Equal(t, types.Int64Value(123), attributes["branch_id"])
+	assert.Equal(t, types.StringValue("ex-generic-v2"), attributes["component_id"])
+	assert.Equal(t, types.StringValue("456"), attributes["configuration_id"])
+
+	for _, id := range []string{"", "123/ex-generic-v2", "abc/ex-generic-v2/456", "0/ex-generic-v2/456", "1//456"} {
+		_, funcErr = run(t, functions.NewParseConfigID(), zero, types.StringValue(id))
+		require.NotNil(t, funcErr, id)
+		assert.Contains(t, funcErr.Text, "invalid configuration ID", id)
+	}
+}
+
+func TestConfigID(t *testing.T) {
+	t.Parallel()
+
+	result, funcErr := run(
+		t,
+		functions.NewConfigID(),
+		types.StringUnknown(),
+		types.Int64Value(123),
+		types.StringValue("ex-generic-v2"),
+		types.StringValue("456"),
+	)
+	require.Nil(t, funcErr)
+	assert.Equal(t, types.StringValue("123/ex-generic-v2/456"), result)
+
+	// The ID is parsed back to the same key
+	key, err := configuration.ParseConfigModelID(result.(types.String).ValueString()) //nolint: forcetypeassert
+	require.NoError(t, err)
+	assert.Equal(t, keboola.ConfigKey{BranchID: 123, ComponentID: "ex-generic-v2", ID: "456"}, key)
+
+	_, funcErr = run(
+		t,
+		functions.NewConfigID(),
+		types.StringUnknown(),
+		types.Int64Value(123),
+		types.StringValue(""),
+		types.StringValue("456"),
+	)
+	require.NotNil(t, funcErr)
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	t.Parallel()
+
+	// The order of the keys is kept
+	result, funcErr := run(
+		t,
+		functions.NewNormalizeJSON(),
+		types.StringUnknown(),
+		types.StringValue("{\n  \"b\": 1,\n  \"a\": {\"d\": [1, 2], \"c\": null}\n}"),
+	)
+	require.Nil(t, funcErr)
+	assert.Equal(t, types.StringValue(`{"b":1,"a":{"d":[1,2],"c":null}}`), result)
+
+	for _, value := range []string{"[1, 2]", "{", "foo"} {
+		_, funcErr = run(t, functions.NewNormalizeJSON(), types.StringUnknown(), types.StringValue(value))
+		require.NotNil(t, funcErr, value)
+	}
+}
+
+func TestIsEncrypted(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]bool{
+		"KBC::ProjectSecure::eJwBYAGf/mE6Mj": true,
+		"KBC::ComponentSecure::abc":          true,
+		"KBC::ProjectSecureKV::abc":          true,
+		"secret":                             false,
+		"":                                   false,
+		" KBC::ProjectSecure::abc":           false,
+		"KBC::::abc":                         false,
+	}
+
+	for value, expected := range cases {
+		result, funcErr := run(t, functions.NewIsEncrypted(), types.BoolUnknown(), types.StringValue(value))
+		require.Nil(t, funcErr, value)
+		assert.Equal(t, types.BoolValue(expected), result, value)
+	}
+}
@@ -0,0 +1,180 @@
+package generator_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/generator"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+// newProject returns a project with a development branch, metadata, configurations with rows and a schedule.
+func newProject(t *testing.T) (*double.Client, keboola.BranchID) {
+	t.Helper()
+
+	client := double.New()
+
+	job, err := client.CreateBranchAsync(t.Context(), &keboola.Branch{Name: "dev", Description: "Development"})
+	require.NoError(t, err)
+	devBranchID := keboola.BranchID(job.Results["id"].(int)) //nolint: forcetypeassert
+
+	require.NoError(t, client.AppendBranchMetadata(
+		t.Context(), keboola.BranchKey{ID: double.DefaultBranchID}, keboola.Metadata{"KBC.projectDescription": "Main"},
+	))
+
+	content := orderedmap.FromPairs([]orderedmap.Pair{
+		{Key: "parameters", Value: orderedmap.FromPairs([]orderedmap.Pair{
+			{Key: "url", Value: "https://example.com/${path}"},
+			{Key: "#token", Value: "KBC::ProjectSecure::abc"},
+			{Key: "limit", Value: 10},
+			{Key: "tags", Value: []any{"a", true, nil}},
+		})},
+	})
+	client.AddConfig(&keboola.ConfigWithRows{
+		Config: &keboola.Config{
+			ConfigKey: keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "123"},
+			Name:      "Currency rates",
+			Content:   content,
+		},
+		Rows: []*keboola.ConfigRow{{
+			ConfigRowKey: keboola.ConfigRowKey{ID: "456"},
+			Name:         "EUR",
+			IsDisabled:   true,
+			Content:      orderedmap.FromPairs([]orderedmap.Pair{{Key: "currency", Value: "EUR"}}),
+		}},
+	})
+	client.AddConfig(&keboola.ConfigWithRows{
+		Config: &keboola.Config{
+			ConfigKey: keboola.ConfigKey{BranchID: devBranchID, ComponentID: "ex-generic-v2", ID: "123"},
+			Name:      "Currency rates",
+		},
+	})
+	client.AddConfig(&keboola.ConfigWithRows{
+		Config: &keboola.Config{
+			ConfigKey: keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "keboola.scheduler", ID: "789"},
+			Name:      "Daily",
+			Content:   orderedmap.New(),
+		},
+	})
+	_, err = client.ActivateSchedule(t.Context(), "789", "")
+	require.NoError(t, err)
+
+	return client, devBranchID
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	client, devBranchID := newProject(t)
+	files, err := generator.New(client).Generate(t.Context())
+	require.NoError(t, err)
+
+	// All files are valid HCL
+	require.Len(t, files, 5)
+	for name, content := range files {
+		_, diags := hclsyntax.ParseConfig(content, name, hcl.InitialPos)
+		require.False(t, diags.HasErrors(), "%s: %s\n%s", name, diags.Error(), content)
+	}
+
+	dev := fmt.Sprintf("branch_%d", devBranchID)
+
+	// The default branch is not managed, its metadata is
+	branches := string(files[generator.BranchesFile])
+	assert.Contains(t, branches, `resource "keboola_branch" "`+dev+`" {`)
+	assert.Contains(t, branches, `description = "Development"`)
+	assert.NotContains(t, branches, `"branch_1"`)
+	assert.Contains(t, branches, `resource "keboola_branch_metadata" "branch_1_KBC_projectDescription" {`)
+	assert.Contains(t, branches, `key       = "KBC.projectDescription"`)
+
+	// The content is rendered in the jsonencode form, template sequences are escaped
+	configs := string(files[generator.ConfigurationsFile])
+	assert.Contains(t, configs, `resource "keboola_component_configuration" "ex-generic-v2_123" {`)
+	assert.Contains(t, configs, `configuration = jsonencode({
+    parameters = {
+      "#token" = "KBC::ProjectSecure::abc"
+      limit    = 10
+      tags     = ["a", true, null]
+      url      = "https://example.com/$${path}"
+    }
+  })`)
+	assert.Contains(t, configs, `configuration_row = jsonencode({
+      currency = "EUR"
+    })`)
+	assert.Contains(t, configs, "    is_disabled = true\n")
+
+	// The configuration of the development branch references the branch
+	assert.Contains(t, configs, `resource "keboola_component_configuration" "`+dev+`_ex-generic-v2_123" {`)
+	assert.Contains(t, configs, `branch_id        = keboola_branch.`+dev+`.id`)
+	assert.Contains(t, configs, `configuration    = jsonencode({})`)
+
+	// The schedule references its configuration
+	schedules := string(files[generator.SchedulesFile])
+	assert.Contains(
+		t, schedules, `configuration_id = keboola_component_configuration.keboola_scheduler_789.configuration_id`,
+	)
+
+	imports := string(files[generator.ImportsFile])
+	assert.Contains(t, imports, `import {
+  to = keboola_component_configuration.ex-generic-v2_123
+  id = "1/ex-generic-v2/123"
+}`)
+	assert.Contains(t, imports, `import {
+  to = keboola_branch_metadata.branch_1_KBC_projectDescription
+  id = "1/KBC.projectDescription"
+}`)
+	assert.Contains(t, imports, fmt.Sprintf("to = keboola_branch.%s\n  id = \"%d\"", dev, devBranchID))
+	assert.Contains(t, imports, "to = keboola_scheduler.schedule_")
+
+	assert.Contains(t, string(files[generator.ProviderFile]), `source = "keboola/keboola"`)
+}
+
+func TestGenerateIsStable(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newProject(t)
+	first, err := generator.New(client).Generate(t.Context())
+	require.NoError(t, err)
+	second, err := generator.New(client).Generate(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestGenerateAPIError(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newProject(t)
+	client.FailNext("ListConfigsAndRows", double.ServerError(503))
+
+	_, err := generator.New(client).Generate(t.Context())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not list configurations of branch 1: Service Unavailable (503)")
+}
+
+func TestFilesWrite(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "project")
+	files := generator.Files{"main.tf": []byte("# main\n")}
+	require.NoError(t, files.Write(dir))
+
+	content, err := os.ReadFile(filepath.Join(dir, "main.tf"))
+	require.NoError(t, err)
+	assert.Equal(t, "# main\n", string(content))
+
+	// Existing files are not overwritten
+	require.ErrorIs(t, files.Write(dir), generator.ErrFileExists)
+
+	// Nothing is written if any of the files exists, even if it is the last one
+	files = generator.Files{"a.tf": []byte("# a\n"), "main.tf": []byte("# new\n")}
+	require.ErrorIs(t, files.Write(dir), generator.ErrFileExists)
+	assert.NoFileExists(t, filepath.Join(dir, "a.tf"))
+}
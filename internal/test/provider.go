@@ -2,258 +2,118 @@
 package test
 
 import (
-	"context"
 	"os"
+	"sync"
+	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
-	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
 
-	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/branch"
-	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/branch/metadata"
-	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
-	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/encryption"
-	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/scheduler"
-	"github.com/keboola/terraform-provider-keboola/internal/providermodels"
+	keboolaprovider "github.com/keboola/terraform-provider-keboola/internal/provider"
+	"github.com/keboola/terraform-provider-keboola/internal/test/fake"
 )
 
-const (
-	KbcHost  = "KBC_HOST"
-	KbcToken = "KBC_TOKEN"
-)
-
-// Ensure the implementation satisfies the expected interfaces.
-var (
-	_ provider.Provider = &testKeboolaProvider{}
-)
-
-// testKeboolaProvider is a simplified provider implementation for testing.
-type testKeboolaProvider struct {
-	version string
-}
+// fakeServer is started on the first use, if the tests do not target a real project.
+var fakeServer = sync.OnceValue(fake.NewServer) //nolint: gochecknoglobals
 
-// testKeboolaProviderModel maps provider schema data to a Go type.
-type testKeboolaProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+// usesFakeServer returns true if TEST_KBC_HOST and TEST_KBC_TOKEN are not set and the cassettes are not replayed.
+func usesFakeServer() bool {
+	return !replaysCassettes() &&
+		os.Getenv("TEST_KBC_HOST") == "" && os.Getenv("TEST_KBC_TOKEN") == "" //nolint: forbidigo
 }
 
-// ProviderConfig returns a provider configuration for testing.
-func ProviderConfig() string {
-	host := os.Getenv("TEST_KBC_HOST")   //nolint: forbidigo
-	token := os.Getenv("TEST_KBC_TOKEN") //nolint: forbidigo
-
-	return `
-provider "keboola" {
-  host  = "` + host + `"
-  token = "` + token + `"
-}
-`
-}
-
-// AccProtoV6ProviderFactories returns a map of provider server factories for testing.
-func AccProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
-	return map[string]func() (tfprotov6.ProviderServer, error){
-		"keboola": providerserver.NewProtocol6WithError(New("test")()),
+// Host returns the API host used by acceptance tests,
+// TEST_KBC_HOST, the address of the fake server or a placeholder when the cassettes are replayed.
+func Host() string {
+	if replaysCassettes() {
+		return replayHost
 	}
-}
 
-// New creates a new provider instance for testing.
-func New(version string) func() provider.Provider {
-	return func() provider.Provider {
-		return &testKeboolaProvider{
-			version: version,
-		}
-	}
-}
-
-// AccPreCheck is a function to run before tests to ensure test environment is properly set up.
-func AccPreCheck() {
-	// This can be expanded to check for required environment variables
-	if os.Getenv("TEST_KBC_HOST") == "" || os.Getenv("TEST_KBC_TOKEN") == "" { //nolint: forbidigo
-		panic("TEST_KBC_HOST and TEST_KBC_TOKEN must be set for acceptance tests")
+	if usesFakeServer() {
+		return fakeServer().URL
 	}
-}
-
-// Metadata returns the provider type name.
-func (p *testKeboolaProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "keboola"
-	resp.Version = p.version
-}
 
-// Schema defines the provider-level schema for configuration data.
-func (p *testKeboolaProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Interact with Keboola Storage API (https://keboola.docs.apiary.io/).",
-		Attributes: map[string]schema.Attribute{
-			"host": schema.StringAttribute{
-				Optional:    true,
-				Description: "URL of the Keboola Connection API. Can be also provided via " + KbcHost + " environment variable.",
-			},
-			"token": schema.StringAttribute{
-				Optional:    true,
-				Sensitive:   true,
-				Description: "API Token used to authenticate against the API. Can be also provided via " + KbcToken + " environment variable.", //nolint: lll
-			},
-		},
-	}
+	return os.Getenv("TEST_KBC_HOST") //nolint: forbidigo
 }
 
-// Configure configures the provider.
-func (p *testKeboolaProvider) Configure(
-	ctx context.Context,
-	req provider.ConfigureRequest,
-	resp *provider.ConfigureResponse,
-) {
-	tflog.Info(ctx, "Configuring Keboola API client for tests")
-
-	// Get the user-provided configuration
-	var config testKeboolaProviderModel
-	diags := req.Config.Get(ctx, &config)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// If practitioner provided a configuration value for any of the
-	// attributes, it must be a known value.
-	if config.Host.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("host"),
-			"Unknown Keboola API Host",
-			"The provider cannot create the Keboola API client as there is an unknown configuration value for the Keboola API host. "+ //nolint: lll
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the "+KbcHost+" environment variable.", //nolint: lll
-		)
+// Token returns the API token used by acceptance tests,
+// TEST_KBC_TOKEN, the master token of the fake server or a placeholder when the cassettes are replayed.
+func Token() string {
+	if replaysCassettes() {
+		return replayToken
 	}
 
-	if config.Token.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("token"),
-			"Unknown Keboola API Token",
-			"The provider cannot create the Keboola API client as there is an unknown configuration value for the Keboola API token. "+ //nolint: lll
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the "+KbcToken+" environment variable.", //nolint: lll
-		)
+	if usesFakeServer() {
+		return fake.Token
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
-	host := os.Getenv(KbcHost)   //nolint: forbidigo
-	token := os.Getenv(KbcToken) //nolint: forbidigo
-
-	if !config.Host.IsNull() {
-		host = config.Host.ValueString()
-	}
-
-	if !config.Token.IsNull() {
-		token = config.Token.ValueString()
-	}
+	return os.Getenv("TEST_KBC_TOKEN") //nolint: forbidigo
+}
 
-	// If any of the expected configurations are missing, return
-	// errors with provider-specific guidance.
-	if host == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("host"),
-			"Missing Keboola API Host",
-			"The provider cannot create the Keboola API client as there is a missing or empty value for the Keboola API host. "+
-				"Set the host value in the configuration or use the "+KbcHost+" environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+// BucketID returns the bucket used by acceptance tests, the provider cannot create buckets.
+// It is TEST_KBC_BUCKET_ID, or the bucket of the fake server. It is empty if the tests target
+// a real project without the variable.
+func BucketID() string {
+	if usesFakeServer() {
+		return fake.BucketID
 	}
 
-	if token == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("token"),
-			"Missing Keboola API token",
-			"The provider cannot create the Keboola API client as there is a missing or empty value for the Keboola API token. "+
-				"Set the token value in the configuration or use the "+KbcToken+" environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
-	}
+	return os.Getenv("TEST_KBC_BUCKET_ID") //nolint: forbidigo
+}
 
-	if resp.Diagnostics.HasError() {
-		return
+// TableID returns the table used by acceptance tests, the provider cannot create tables.
+// It is TEST_KBC_TABLE_ID, or the table of the fake server. It is empty if the tests target
+// a real project without the variable.
+func TableID() string {
+	if usesFakeServer() {
+		return fake.TableID
 	}
 
-	ctx = tflog.SetField(ctx, "keboola_host", host)
-	ctx = tflog.SetField(ctx, "keboola_token", token)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "keboola_token")
-
-	// Create a new Keboola Storage API client using the configuration values
-	sapiClient, err := keboola.NewAuthorizedAPI(ctx, host, token)
-	if err != nil {
-		resp.Diagnostics.AddError("Could not initialize Keboola client", err.Error())
+	return os.Getenv("TEST_KBC_TABLE_ID") //nolint: forbidigo
+}
 
-		return
+// TableColumn returns a column of the table used by acceptance tests.
+// It is TEST_KBC_TABLE_COLUMN, or a column of the table of the fake server.
+func TableColumn() string {
+	if usesFakeServer() {
+		return fake.TableColumns[0]
 	}
 
-	tokenObject, err := sapiClient.VerifyTokenRequest(token).Send(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Could not initialize Keboola client, given token is invalid:", err.Error())
-
-		return
-	}
+	return os.Getenv("TEST_KBC_TABLE_COLUMN") //nolint: forbidigo
+}
 
-	// Retrieve all components from Keboola Connection
-	tflog.Info(ctx, "Fetching all components from Keboola Connection")
-	// The IndexComponentsRequest retrieves all components available in the Keboola Connection project.
-	// This is a one-time fetch during provider configuration to avoid repeated API calls.
-	stackComponents, err := sapiClient.IndexComponentsRequest().Send(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to retrieve components from Keboola Connection", err.Error())
+// ProviderConfig returns a provider configuration for testing.
+func ProviderConfig() string {
+	return `
+provider "keboola" {
+  host  = "` + Host() + `"
+  token = "` + Token() + `"
+}
+`
+}
 
-		return
-	}
+// AccProtoV6ProviderFactories returns a map of provider server factories for testing.
+// The API requests of the test are recorded or replayed according to TEST_KBC_CASSETTE, see Transport.
+func AccProtoV6ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
 
-	// Make the Keboola client available during DataSource and Resource
-	// type Configure methods.
-	data := &providermodels.ProviderData{
-		Client:     sapiClient,
-		Token:      tokenObject,
-		Components: stackComponents.Components,
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"keboola": providerserver.NewProtocol6WithError(New("test", keboolaprovider.WithTransport(Transport(t)))()),
 	}
-
-	// Set the provider data
-	resp.DataSourceData = data
-	resp.ResourceData = data
-
-	tflog.Info(ctx, "Configured Keboola API client for tests")
 }
 
-// DataSources defines the data sources implemented by the provider.
-// This is intentionally empty for testing purposes.
-func (p *testKeboolaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+// New creates the shipped provider for testing, so the tests exercise exactly its schema, configuration and resources.
+// The options replace e.g. the API transport, the component index or the resources.
+func New(version string, opts ...keboolaprovider.Option) func() provider.Provider {
+	return keboolaprovider.New(version, opts...)
 }
 
-// Resources defines the resources implemented by the provider.
-// This adds resource factories for testing purposes only.
-func (p *testKeboolaProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
-		func() resource.Resource {
-			return configuration.NewResource()
-		},
-		func() resource.Resource {
-			return encryption.NewResource()
-		},
-		func() resource.Resource {
-			return scheduler.NewResource()
-		},
-		func() resource.Resource {
-			return branch.NewResource()
-		},
-		func() resource.Resource {
-			return metadata.NewResource()
-		},
+// AccPreCheck is a function to run before tests to ensure test environment is properly set up.
+// Without TEST_KBC_HOST and TEST_KBC_TOKEN, the tests run against the in-memory fake of the Keboola APIs,
+// or replay the cassettes.
+func AccPreCheck() {
+	if (os.Getenv("TEST_KBC_HOST") == "") != (os.Getenv("TEST_KBC_TOKEN") == "") { //nolint: forbidigo
+		panic("TEST_KBC_HOST and TEST_KBC_TOKEN must be both set, or both unset to use the fake API server")
 	}
 }
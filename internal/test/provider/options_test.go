@@ -0,0 +1,41 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+
+	keboolaprovider "github.com/keboola/terraform-provider-keboola/internal/provider"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/branch"
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+)
+
+func resourceTypeNames(t *testing.T, factories []func() resource.Resource) []string {
+	t.Helper()
+
+	names := make([]string, 0, len(factories))
+	for _, factory := range factories {
+		resp := &resource.MetadataResponse{}
+		factory().Metadata(t.Context(), resource.MetadataRequest{ProviderTypeName: "keboola"}, resp)
+		names = append(names, resp.TypeName)
+	}
+
+	return names
+}
+
+func TestProviderResources(t *testing.T) {
+	t.Parallel()
+
+	// The tests use the shipped provider with all its resources
+	names := resourceTypeNames(t, test.New("test")().Resources(t.Context()))
+	assert.Contains(t, names, "keboola_branch")
+	assert.Contains(t, names, "keboola_component_configuration")
+	assert.Contains(t, names, "keboola_scheduler")
+	assert.Contains(t, names, "keboola_storage_token")
+
+	// The resources can be replaced
+	onlyBranch := keboolaprovider.WithResources(func() resource.Resource { return branch.NewResource() })
+	names = resourceTypeNames(t, test.New("test", onlyBranch)().Resources(t.Context()))
+	assert.Equal(t, []string{"keboola_branch"}, names)
+}
@@ -0,0 +1,102 @@
+package provider_test
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/common"
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+)
+
+func preflightProviderConfig(resourceTypes string) string {
+	host := test.Host()
+	token := test.Token()
+
+	return `
+provider "keboola" {
+  host  = "` + host + `"
+  token = "` + token + `"
+  preflight_resource_types = ` + resourceTypes + `
+}
+`
+}
+
+func TestMissingPermissions(t *testing.T) {
+	t.Parallel()
+
+	token := &keboola.Token{
+		ID:               "123",
+		CanManageBuckets: true,
+		ComponentAccess:  []string{"ex-generic-v2"},
+	}
+
+	missing := common.MissingPermissions(token, []string{
+		"keboola_branch",
+		"keboola_component_configuration",
+		"keboola_encryption",
+		"keboola_job",
+		"keboola_scheduler",
+		"keboola_storage_metadata",
+		"keboola_storage_token",
+	})
+	assert.Equal(t, []string{"keboola_branch", "keboola_scheduler", "keboola_storage_token"}, sortedKeys(missing))
+
+	// Running jobs requires access to a component
+	noComponents := &keboola.Token{ID: "456"}
+	missing = common.MissingPermissions(noComponents, []string{"keboola_job"})
+	assert.Equal(t, map[string]string{"keboola_job": "the token has no access to any component"}, missing)
+	diags := common.CheckPermissions(path.Root("preflight_resource_types"), noComponents, []string{"keboola_job"})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Insufficient Token Permissions", diags[0].Summary())
+
+	// Master tokens can manage everything
+	token.IsMaster = true
+	assert.Empty(t, common.MissingPermissions(token, common.PermissionCheckedResourceTypes()))
+
+	// Unknown resource types are reported
+	diags = common.CheckPermissions(path.Root("preflight_resource_types"), token, []string{"keboola_unknown"})
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unknown Resource Type", diags[0].Summary())
+}
+
+func TestAccProviderPreflightCheck(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Encryption can be managed with any token
+			{
+				Config: preflightProviderConfig(`["keboola_encryption"]`) + `
+data "keboola_current_token" "test" {}
+`,
+				Check: resource.TestCheckResourceAttrSet("data.keboola_current_token.test", "id"),
+			},
+			// Unknown resource types fail the configuration
+			{
+				Config: preflightProviderConfig(`["keboola_unknown"]`) + `
+data "keboola_current_token" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Unknown Resource Type`),
+			},
+		},
+	})
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
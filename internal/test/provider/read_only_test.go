@@ -0,0 +1,50 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+)
+
+func readOnlyProviderConfig() string {
+	host := test.Host()
+	token := test.Token()
+
+	return `
+provider "keboola" {
+  host      = "` + host + `"
+  token     = "` + token + `"
+  read_only = true
+}
+`
+}
+
+func TestAccProviderReadOnly(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Data sources keep working
+			{
+				Config: readOnlyProviderConfig() + `
+data "keboola_current_token" "test" {}
+`,
+				Check: resource.TestCheckResourceAttrSet("data.keboola_current_token.test", "id"),
+			},
+			// Creating a resource is refused before calling the API
+			{
+				Config: readOnlyProviderConfig() + `
+resource "keboola_branch" "test" {
+  name = "read-only"
+}
+`,
+				ExpectError: regexp.MustCompile(`Provider is in read-only mode`),
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package branch_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/branch"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func TestBranchResourceWithDoubleIdentity(t *testing.T) {
+	t.Parallel()
+
+	r := double.NewResource(t, double.New(), branch.NewResource())
+
+	// The create sets the identity
+	state, diags := r.Create(t, r.Plan(t, map[string]attr.Value{"name": types.StringValue("dev")}))
+	require.False(t, diags.HasError(), diags)
+	id := double.Int64Attribute(t, state, "id")
+	assert.Equal(t, id, double.IdentityAttribute[types.Int64](t, r, "id").ValueInt64())
+
+	// The import by the ID sets the identity
+	_, diags = r.ImportState(t, strconv.FormatInt(id, 10))
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, id, double.IdentityAttribute[types.Int64](t, r, "id").ValueInt64())
+
+	// The import by the identity sets the ID, the read fills the rest
+	imported, diags := r.ImportStateByIdentity(t, map[string]attr.Value{"id": types.Int64Value(id)})
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, id, double.Int64Attribute(t, imported, "id"))
+
+	imported, diags = r.Read(t, imported)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "dev", double.StringAttribute(t, imported, "name"))
+	assert.Equal(t, id, double.IdentityAttribute[types.Int64](t, r, "id").ValueInt64())
+
+	_, diags = r.ImportState(t, "main")
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid import ID", diags.Errors()[0].Summary())
+}
+
+func TestBranchListResourceWithDouble(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+	r := double.NewResource(t, client, branch.NewResource())
+	l := double.NewListResource(t, client, branch.NewListResource(), branch.NewResource())
+	created, diags := r.Create(t, r.Plan(t, map[string]attr.Value{"name": types.StringValue("dev")}))
+	require.False(t, diags.HasError(), diags)
+	id := double.Int64Attribute(t, created, "id")
+
+	// The branches are sorted by the ID, the default branch is the first one
+	results := l.List(t, nil, false)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	}
+	assert.Equal(t, "Main", results[0].DisplayName)
+	defaultID := double.ListIdentityAttribute[types.Int64](t, results[0], "id")
+	assert.Equal(t, int64(double.DefaultBranchID), defaultID.ValueInt64())
+	assert.Equal(t, "dev", results[1].DisplayName)
+	assert.Equal(t, id, double.ListIdentityAttribute[types.Int64](t, results[1], "id").ValueInt64())
+
+	// The resource is the state of the import followed by a read
+	results = l.List(t, nil, true)
+	require.Len(t, results, 2)
+	require.False(t, results[1].Diagnostics.HasError(), results[1].Diagnostics)
+	listed := double.State(t, results[1])
+	assert.Equal(t, "dev", double.StringAttribute(t, listed, "name"))
+
+	imported, diags := r.ImportStateByIdentity(t, map[string]attr.Value{
+		"id": double.ListIdentityAttribute[types.Int64](t, results[1], "id"),
+	})
+	require.False(t, diags.HasError(), diags)
+	imported, diags = r.Read(t, imported)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, listed.Raw, imported.Raw)
+
+	// A failed listing results in a single error
+	client.FailNext("ListBranches", double.ServerError(http.StatusInternalServerError))
+	results = l.List(t, nil, false)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Diagnostics.HasError())
+	assert.Equal(t, "Error listing branches", results[0].Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,41 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/branch/metadata"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func TestBranchMetadataResourceWithDoubleImport(t *testing.T) {
+	t.Parallel()
+
+	r := double.NewResource(t, double.New(), metadata.NewResource())
+	key := keboola.BranchKey{ID: double.DefaultBranchID}
+	require.NoError(t, r.Client.AppendBranchMetadata(t.Context(), key, keboola.Metadata{"KBC.description": "main"}))
+
+	// The import sets the branch and the key, the read fills the rest
+	state, diags := r.ImportState(t, "1/KBC.description")
+	require.False(t, diags.HasError(), diags)
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.NotEmpty(t, double.StringAttribute(t, state, "id"))
+	assert.Equal(t, "main", double.StringAttribute(t, state, "value"))
+
+	// A missing key is removed from the state, so Terraform reports the import of a non-existent object
+	state, diags = r.ImportState(t, "1/missing")
+	require.False(t, diags.HasError(), diags)
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, state.Raw.IsNull())
+
+	for _, id := range []string{"KBC.description", "main/KBC.description", "1/"} {
+		_, diags = r.ImportState(t, id)
+		require.True(t, diags.HasError(), id)
+		assert.Equal(t, "Invalid import ID", diags.Errors()[0].Summary(), id)
+	}
+}
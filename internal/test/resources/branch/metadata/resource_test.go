@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/keboola/terraform-provider-keboola/internal/test"
 )
@@ -31,7 +32,7 @@ func TestAccBranchMetadataResource(t *testing.T) {
 	t.Parallel()
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(),
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
 		PreCheck:                 test.AccPreCheck,
 		ExternalProviders: map[string]resource.ExternalProvider{
 			"random": {
@@ -58,6 +59,17 @@ func TestAccBranchMetadataResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("keboola_branch_metadata.description", "value"),
 				),
 			},
+			// Import the metadata by the branch ID and the key
+			{
+				ResourceName: "keboola_branch_metadata.description",
+				ImportState:  true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attributes := state.RootModule().Resources["keboola_branch_metadata.description"].Primary.Attributes
+
+					return attributes["branch_id"] + "/" + attributes["key"], nil
+				},
+				ImportStateVerify: true,
+			},
 			// Attempt to update the branch metadata
 			{
 				Config: test.ProviderConfig() + testResource("keboola_branch", "test1", map[string]any{
@@ -2,6 +2,7 @@ package branch_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -31,7 +32,7 @@ func TestAccBranchResource(t *testing.T) {
 	t.Parallel()
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(),
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
 		PreCheck:                 test.AccPreCheck,
 		ExternalProviders: map[string]resource.ExternalProvider{
 			"random": {
@@ -50,6 +51,13 @@ func TestAccBranchResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("keboola_branch.test", "name"),
 				),
 			},
+			// Import the branch by its ID
+			{
+				ResourceName:            "keboola_branch.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
 			// Attempt to update the branch
 			{
 				Config: test.ProviderConfig() + testBranchResource("test", map[string]any{
@@ -61,3 +69,79 @@ func TestAccBranchResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBranchResourceTimeouts(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Reject an invalid duration
+			{
+				Config: test.ProviderConfig() + `
+resource "keboola_branch" "test" {
+  name = "test timeouts"
+  timeouts {
+    create = "soon"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+			},
+			// Create a branch with custom timeouts
+			{
+				Config: test.ProviderConfig() + `
+resource "keboola_branch" "test" {
+  name = "test timeouts"
+  timeouts {
+    create = "30m"
+    delete = "30m"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keboola_branch.test", "id"),
+					resource.TestCheckResourceAttr("keboola_branch.test", "timeouts.create", "30m"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBranchResourceDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	protected := func(enabled bool) string {
+		return testBranchResource("protected", map[string]any{
+			"name":                "test deletion protection",
+			"deletion_protection": enabled,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Create a protected branch
+			{
+				Config: test.ProviderConfig() + protected(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_branch.protected", "deletion_protection", "true"),
+				),
+			},
+			// Removing the branch from the configuration is refused
+			{
+				Config:      test.ProviderConfig(),
+				ExpectError: regexp.MustCompile(`Resource is protected from deletion`),
+			},
+			// Disable the protection, the branch is then destroyed at the end of the test
+			{
+				Config: test.ProviderConfig() + protected(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_branch.protected", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
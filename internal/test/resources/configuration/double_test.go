@@ -0,0 +1,505 @@
+package configuration_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func newDoubleResource(t *testing.T) *double.Resource {
+	t.Helper()
+
+	return double.NewResource(t, double.New(), configuration.NewResource())
+}
+
+func configPlanAttributes(content string) map[string]attr.Value {
+	return map[string]attr.Value{
+		"component_id":     types.StringValue("ex-generic-v2"),
+		"configuration_id": types.StringValue("aaa"),
+		"branch_id":        types.Int64Value(int64(double.DefaultBranchID)),
+		"name":             types.StringValue("test"),
+		"configuration":    types.StringValue(content),
+	}
+}
+
+func TestConfigResourceWithDouble(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+
+	// Create
+	state, diags := r.Create(t, r.Plan(t, configPlanAttributes(`{"foo":"bar"}`)))
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "1/ex-generic-v2/aaa", double.StringAttribute(t, state, "id"))
+	assert.Equal(t, "aaa", double.IdentityAttribute[types.String](t, r, "configuration_id").ValueString())
+
+	remote, err := r.Client.GetConfig(t.Context(), key)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", remote.Content.GetOrNil("foo"))
+
+	// Read
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{"foo":"bar"}`, double.StringAttribute(t, state, "configuration"))
+
+	// Update
+	attributes := configPlanAttributes(`{"foo":"baz"}`)
+	attributes["version"] = types.Int64Value(1)
+	state, diags = r.Update(t, state, r.Plan(t, attributes))
+	require.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{"foo":"baz"}`, double.StringAttribute(t, state, "configuration"))
+	assert.Equal(t, 1, r.Client.Calls("UpdateConfig"))
+}
+
+// configRow returns a planned row with the content, the row is matched by the ID if it is set.
+func configRow(id, name, content string) configuration.RowModel {
+	row := configuration.RowModel{
+		Name:        types.StringValue(name),
+		Content:     types.StringValue(content),
+		IgnorePaths: types.ListNull(types.StringType),
+	}
+	if id != "" {
+		row.ID = types.StringValue(id)
+	}
+
+	return row
+}
+
+// rowsValue returns the value of the rows attribute with the rows.
+func rowsValue(t *testing.T, r *double.Resource, rows ...configuration.RowModel) types.List {
+	t.Helper()
+
+	rowsType, diags := r.Plan(t, nil).Schema.TypeAtPath(t.Context(), path.Root("rows"))
+	require.False(t, diags.HasError(), diags)
+
+	listType, ok := rowsType.(types.ListType)
+	require.True(t, ok)
+
+	value, diags := types.ListValueFrom(t.Context(), listType.ElemType, rows)
+	require.False(t, diags.HasError(), diags)
+
+	return value
+}
+
+// storedRow returns a row of the configuration in the double.
+func storedRow(key keboola.ConfigKey, id, name, content string) *keboola.ConfigRow {
+	rowKey := keboola.ConfigRowKey{BranchID: key.BranchID, ComponentID: key.ComponentID, ConfigID: key.ID}
+	rowKey.ID = keboola.RowID(id)
+
+	return &keboola.ConfigRow{
+		ConfigRowKey: rowKey,
+		Name:         name,
+		Content:      orderedmap.FromPairs([]orderedmap.Pair{{Key: "foo", Value: content}}),
+	}
+}
+
+func TestConfigResourceWithDoubleRestoresFromTrash(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+	r.Client.AddConfig(&keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, Content: orderedmap.New()}})
+	require.NoError(t, r.Client.DeleteConfig(t.Context(), key))
+
+	// The configuration in trash conflicts with the new one, it is restored instead
+	attributes := configPlanAttributes(`{"foo":"bar"}`)
+	attributes["restore_if_deleted"] = types.BoolValue(true)
+	_, diags := r.Create(t, r.Plan(t, attributes))
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, 1, r.Client.Calls("RestoreConfig"))
+
+	remote, err := r.Client.GetConfig(t.Context(), key)
+	require.NoError(t, err)
+	assert.Equal(t, "test", remote.Name)
+}
+
+func TestConfigResourceWithDoubleRestoresRowsFromTrash(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		restored []string
+		planned  func(r *double.Resource) types.List
+		expected map[string]string
+		deleted  []string
+	}{
+		{
+			name:     "rows without ID are matched in order",
+			restored: []string{"r1", "r2", "r3"},
+			planned: func(r *double.Resource) types.List {
+				return rowsValue(t, r, configRow("", "first", `{"foo":"new1"}`), configRow("", "second", `{"foo":"new2"}`))
+			},
+			expected: map[string]string{"r1": "new1", "r2": "new2"},
+			deleted:  []string{"r3"},
+		},
+		{
+			name:     "rows with ID are matched by the ID",
+			restored: []string{"r1", "r2"},
+			planned: func(r *double.Resource) types.List {
+				return rowsValue(t, r,
+					configRow("", "first", `{"foo":"new1"}`),
+					configRow("r2", "second", `{"foo":"new2"}`),
+					configRow("", "third", `{"foo":"new3"}`),
+				)
+			},
+			expected: map[string]string{"r1": "new1", "r2": "new2"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newDoubleResource(t)
+			key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+			config := &keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, Content: orderedmap.New()}}
+			for _, id := range tc.restored {
+				config.Rows = append(config.Rows, storedRow(key, id, "old", "old"))
+			}
+			r.Client.AddConfig(config)
+			require.NoError(t, r.Client.DeleteConfig(t.Context(), key))
+
+			attributes := configPlanAttributes(`{"foo":"bar"}`)
+			attributes["restore_if_deleted"] = types.BoolValue(true)
+			attributes["rows"] = tc.planned(r)
+			state, diags := r.Create(t, r.Plan(t, attributes))
+			require.False(t, diags.HasError(), diags)
+
+			// The restored rows have the planned content, the other planned rows are created
+			rows, err := r.Client.ListConfigRows(t.Context(), keboola.ConfigRowKey{
+				BranchID: key.BranchID, ComponentID: key.ComponentID, ConfigID: key.ID,
+			})
+			require.NoError(t, err)
+
+			planned := tc.planned(r).Elements()
+			assert.Len(t, rows, len(planned))
+
+			for _, row := range rows {
+				assert.NotContains(t, tc.deleted, row.ID.String())
+				if expected, ok := tc.expected[row.ID.String()]; ok {
+					assert.Equal(t, expected, row.Content.GetOrNil("foo"), row.ID)
+				}
+				assert.NotEqual(t, "old", row.Name, row.ID)
+			}
+
+			var stateRows []configuration.RowModel
+			diags = state.GetAttribute(t.Context(), path.Root("rows"), &stateRows)
+			require.False(t, diags.HasError(), diags)
+			assert.Len(t, stateRows, len(planned))
+		})
+	}
+}
+
+func TestConfigResourceWithDoubleRestoresOnlyFromTrash(t *testing.T) {
+	t.Parallel()
+
+	attributes := configPlanAttributes(`{"foo":"bar"}`)
+	attributes["restore_if_deleted"] = types.BoolValue(true)
+
+	t.Run("active configuration", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+		r.Client.AddConfig(&keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, Content: orderedmap.New()}})
+
+		_, diags := r.Create(t, r.Plan(t, attributes))
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "could not create configuration: configuration aaa already exists")
+		assert.Equal(t, 0, r.Client.Calls("RestoreConfig"))
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		r.Client.FailNext("CreateConfig", double.ServerError(http.StatusServiceUnavailable))
+
+		_, diags := r.Create(t, r.Plan(t, attributes))
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "could not create configuration: Service Unavailable (503)")
+		assert.Equal(t, 0, r.Client.Calls("GetConfig"))
+		assert.Equal(t, 0, r.Client.Calls("RestoreConfig"))
+	})
+}
+
+func TestConfigResourceWithDoubleErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("server error", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		r.Client.FailNext("CreateConfig", double.ServerError(http.StatusServiceUnavailable))
+
+		_, diags := r.Create(t, r.Plan(t, configPlanAttributes(`{}`)))
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "could not create configuration: Service Unavailable (503)")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		state, diags := r.Create(t, r.Plan(t, configPlanAttributes(`{}`)))
+		require.False(t, diags.HasError(), diags)
+
+		r.Client.FailNext("GetConfig", double.NotFound("configuration aaa not found"))
+		_, diags = r.Read(t, state)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "could not read Configuration 1/ex-generic-v2/aaa")
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		state, diags := r.Create(t, r.Plan(t, configPlanAttributes(`{}`)))
+		require.False(t, diags.HasError(), diags)
+
+		// The configuration has been changed outside of Terraform
+		key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+		_, err := r.Client.UpdateConfig(t.Context(), &keboola.ConfigWithRows{
+			Config: &keboola.Config{ConfigKey: key, Name: "changed"},
+		}, []string{"name"})
+		require.NoError(t, err)
+
+		attributes := configPlanAttributes(`{"foo":"bar"}`)
+		attributes["version"] = types.Int64Value(1)
+		_, diags = r.Update(t, state, r.Plan(t, attributes))
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Conflict with remote changes", diags.Errors()[0].Summary())
+	})
+}
+
+func TestConfigResourceWithDoubleImport(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+	content := orderedmap.FromPairs([]orderedmap.Pair{{Key: "foo", Value: "bar"}})
+	r.Client.AddConfig(&keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, Name: "existing", Content: content}})
+
+	// The import sets the key attributes, the read fills the rest
+	state, diags := r.ImportState(t, "1/ex-generic-v2/aaa")
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "ex-generic-v2", double.StringAttribute(t, state, "component_id"))
+	assert.Equal(t, "aaa", double.StringAttribute(t, state, "configuration_id"))
+
+	assert.Equal(t, "aaa", double.IdentityAttribute[types.String](t, r, "configuration_id").ValueString())
+
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "1/ex-generic-v2/aaa", double.StringAttribute(t, state, "id"))
+	assert.Equal(t, "existing", double.StringAttribute(t, state, "name"))
+	assert.JSONEq(t, `{"foo":"bar"}`, double.StringAttribute(t, state, "configuration"))
+	assert.Equal(t, int64(1), double.IdentityAttribute[types.Int64](t, r, "branch_id").ValueInt64())
+	assert.Equal(t, "ex-generic-v2", double.IdentityAttribute[types.String](t, r, "component_id").ValueString())
+
+	// The import by the identity sets the same key attributes
+	state, diags = r.ImportStateByIdentity(t, map[string]attr.Value{
+		"branch_id":        types.Int64Value(1),
+		"component_id":     types.StringValue("ex-generic-v2"),
+		"configuration_id": types.StringValue("aaa"),
+	})
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "1/ex-generic-v2/aaa", double.StringAttribute(t, state, "id"))
+	assert.Equal(t, "aaa", double.IdentityAttribute[types.String](t, r, "configuration_id").ValueString())
+
+	for _, id := range []string{"aaa", "1/ex-generic-v2", "main/ex-generic-v2/aaa"} {
+		_, diags = r.ImportState(t, id)
+		require.True(t, diags.HasError(), id)
+		assert.Equal(t, "Invalid import ID", diags.Errors()[0].Summary(), id)
+	}
+}
+
+func TestConfigResourceWithDoubleIgnorePaths(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+
+	attributes := configPlanAttributes(`{"foo":"bar","auth":"old"}`)
+	attributes["ignore_paths"] = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("/auth")})
+	state, diags := r.Create(t, r.Plan(t, attributes))
+	require.False(t, diags.HasError(), diags)
+
+	// The ignored path is changed outside of Terraform
+	_, err := r.Client.UpdateConfig(t.Context(), &keboola.ConfigWithRows{Config: &keboola.Config{
+		ConfigKey: key,
+		Content:   orderedmap.FromPairs([]orderedmap.Pair{{Key: "foo", Value: "bar"}, {Key: "auth", Value: "new"}}),
+	}}, []string{"configuration"})
+	require.NoError(t, err)
+
+	// The read keeps the prior value, so there is no diff
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{"foo":"bar","auth":"old"}`, double.StringAttribute(t, state, "configuration"))
+
+	// The update keeps the remote value
+	attributes["configuration"] = types.StringValue(`{"foo":"baz","auth":"old"}`)
+	attributes["force_overwrite"] = types.BoolValue(true)
+	_, diags = r.Update(t, state, r.Plan(t, attributes))
+	require.False(t, diags.HasError(), diags)
+
+	remote, err := r.Client.GetConfig(t.Context(), key)
+	require.NoError(t, err)
+	assert.Equal(t, "baz", remote.Content.GetOrNil("foo"))
+	assert.Equal(t, "new", remote.Content.GetOrNil("auth"))
+}
+
+func TestConfigResourceWithDoubleRowIgnorePaths(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+	rowKey := keboola.ConfigRowKey{BranchID: key.BranchID, ComponentID: key.ComponentID, ConfigID: key.ID}
+	r.Client.AddConfig(&keboola.ConfigWithRows{
+		Config: &keboola.Config{ConfigKey: key, Name: "test", Content: orderedmap.New()},
+		Rows:   []*keboola.ConfigRow{storedRow(key, "r1", "first", "a"), storedRow(key, "r2", "second", "b")},
+	})
+
+	state, diags := r.ImportState(t, "1/ex-generic-v2/aaa")
+	require.False(t, diags.HasError(), diags)
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+
+	// The ignored path of the first row is changed outside of Terraform
+	changed := storedRow(key, "r1", "first", "changed")
+	_, err := r.Client.UpdateConfigRow(t.Context(), changed, []string{"configuration"})
+	require.NoError(t, err)
+
+	// The rows are reordered, the row with the ignored path is matched by the ID
+	first := configRow("r1", "first", `{"foo":"a","bar":"updated"}`)
+	first.IgnorePaths = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("/foo")})
+	attributes := configPlanAttributes(`{}`)
+	attributes["force_overwrite"] = types.BoolValue(true)
+	attributes["rows"] = rowsValue(t, r, configRow("r2", "second", `{"foo":"b","bar":"updated"}`), first)
+	_, diags = r.Update(t, state, r.Plan(t, attributes))
+	require.False(t, diags.HasError(), diags)
+
+	rows, err := r.Client.ListConfigRows(t.Context(), rowKey)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	contents := make(map[keboola.RowID]*orderedmap.OrderedMap)
+	for _, row := range rows {
+		contents[row.ID] = row.Content
+	}
+	assert.Equal(t, "changed", contents["r1"].GetOrNil("foo"))
+	assert.Equal(t, "updated", contents["r1"].GetOrNil("bar"))
+	assert.Equal(t, "b", contents["r2"].GetOrNil("foo"))
+	assert.Equal(t, "updated", contents["r2"].GetOrNil("bar"))
+}
+
+func TestConfigResourceWithDoubleResetState(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"}
+	rowKey := keboola.ConfigRowKey{BranchID: key.BranchID, ComponentID: key.ComponentID, ConfigID: key.ID}
+	lastID := orderedmap.FromPairs([]orderedmap.Pair{{Key: "lastId", Value: 123}})
+	row := storedRow(key, "r1", "first", "a")
+	row.State = lastID
+	r.Client.AddConfig(&keboola.ConfigWithRows{
+		Config: &keboola.Config{ConfigKey: key, Name: "test", Content: orderedmap.New(), State: lastID},
+		Rows:   []*keboola.ConfigRow{row},
+	})
+
+	state, diags := r.ImportState(t, "1/ex-generic-v2/aaa")
+	require.False(t, diags.HasError(), diags)
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+
+	// The trigger changes, the planned row keeps its state like UseStateForUnknown does
+	planned := configRow("r1", "first", `{"foo":"a"}`)
+	planned.State = types.StringValue(`{"lastId":123}`)
+	attributes := configPlanAttributes(`{}`)
+	attributes["reset_state_on_change"] = types.StringValue("query-v2")
+	attributes["rows"] = rowsValue(t, r, planned)
+	state, diags = r.Update(t, state, r.Plan(t, attributes))
+	require.False(t, diags.HasError(), diags)
+	assert.JSONEq(t, `{}`, double.StringAttribute(t, state, "state"))
+
+	// Only the state is changed
+	remote, err := r.Client.GetConfig(t.Context(), key)
+	require.NoError(t, err)
+	assert.Equal(t, 0, remote.State.Len())
+
+	rows, err := r.Client.ListConfigRows(t.Context(), rowKey)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, 0, rows[0].State.Len())
+	assert.Equal(t, "a", rows[0].Content.GetOrNil("foo"))
+	assert.Equal(t, "first", rows[0].Name)
+	assert.Equal(t, 1, r.Client.Calls("UpdateConfigRow"))
+}
+
+func TestConfigListResourceWithDouble(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+	l := double.NewListResource(t, client, configuration.NewListResource(), configuration.NewResource())
+	for _, key := range []keboola.ConfigKey{
+		{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "bbb"},
+		{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"},
+		{BranchID: double.DefaultBranchID, ComponentID: "keboola.orchestrator", ID: "ccc"},
+	} {
+		content := orderedmap.FromPairs([]orderedmap.Pair{{Key: "id", Value: key.ID.String()}})
+		client.AddConfig(&keboola.ConfigWithRows{
+			Config: &keboola.Config{ConfigKey: key, Name: "config " + key.ID.String(), Content: content},
+		})
+	}
+
+	// The configurations of the default branch are sorted by the component and the ID
+	results := l.List(t, nil, false)
+	require.Len(t, results, 3)
+	var ids []string
+	for _, result := range results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		assert.Equal(t, int64(1), double.ListIdentityAttribute[types.Int64](t, result, "branch_id").ValueInt64())
+		ids = append(ids, double.ListIdentityAttribute[types.String](t, result, "configuration_id").ValueString())
+	}
+	assert.Equal(t, []string{"aaa", "bbb", "ccc"}, ids)
+	assert.Equal(t, "config aaa", results[0].DisplayName)
+	componentID := double.ListIdentityAttribute[types.String](t, results[0], "component_id")
+	assert.Equal(t, "ex-generic-v2", componentID.ValueString())
+
+	// The resource is the state of the import followed by a read
+	results = l.List(t, map[string]attr.Value{"component_id": types.StringValue("keboola.orchestrator")}, true)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Diagnostics.HasError(), results[0].Diagnostics)
+	listed := double.State(t, results[0])
+	assert.Equal(t, "1/keboola.orchestrator/ccc", double.StringAttribute(t, listed, "id"))
+	assert.Equal(t, "config ccc", double.StringAttribute(t, listed, "name"))
+	assert.JSONEq(t, `{"id":"ccc"}`, double.StringAttribute(t, listed, "configuration"))
+
+	// The listed identity imports the configuration
+	r := double.NewResource(t, client, configuration.NewResource())
+	state, diags := r.ImportStateByIdentity(t, map[string]attr.Value{
+		"branch_id":        double.ListIdentityAttribute[types.Int64](t, results[0], "branch_id"),
+		"component_id":     double.ListIdentityAttribute[types.String](t, results[0], "component_id"),
+		"configuration_id": double.ListIdentityAttribute[types.String](t, results[0], "configuration_id"),
+	})
+	require.False(t, diags.HasError(), diags)
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, listed.Raw, state.Raw)
+
+	// An unknown branch results in a single error
+	results = l.List(t, map[string]attr.Value{"branch_id": types.Int64Value(999)}, false)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Diagnostics.HasError())
+	assert.Equal(t, "Error listing component configurations", results[0].Diagnostics.Errors()[0].Summary())
+}
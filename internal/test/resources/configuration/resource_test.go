@@ -2,7 +2,6 @@ package configuration_test
 
 import (
 	"fmt"
-	"os"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -12,8 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/keboola/go-utils/pkg/orderedmap"
 	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/configuration"
 	"github.com/keboola/terraform-provider-keboola/internal/test"
 )
 
@@ -80,6 +81,8 @@ func checkAllAttributesSet(resourceID string) resource.TestCheckFunc {
 		resource.TestCheckResourceAttrSet(fullResourceID, "created"),
 		resource.TestCheckResourceAttrSet(fullResourceID, "is_disabled"),
 		resource.TestCheckResourceAttrSet(fullResourceID, "configuration"),
+		resource.TestCheckResourceAttrSet(fullResourceID, "version"),
+		resource.TestCheckResourceAttr(fullResourceID, "force_overwrite", "false"),
 	)
 }
 
@@ -101,9 +104,6 @@ func testAccCheckExampleConfigMatchesReality(t *testing.T, resourceName string)
 			return test.NewResourceNotFoundError(resourceName)
 		}
 
-		host := os.Getenv("TEST_KBC_HOST")   //nolint: forbidigo
-		token := os.Getenv("TEST_KBC_TOKEN") //nolint: forbidigo
-
 		attributes := rs.Primary.Attributes
 		branchID, err := strconv.Atoi(attributes["branch_id"])
 		if err != nil {
@@ -115,8 +115,7 @@ func testAccCheckExampleConfigMatchesReality(t *testing.T, resourceName string)
 			ComponentID: keboola.ComponentID(attributes["component_id"]),
 		}
 		ctx := t.Context()
-		sapiClient, err := keboola.NewAuthorizedAPI(ctx, host, token)
-		require.NoError(t, err)
+		sapiClient := test.APIClient(t)
 
 		storedConfig, err := sapiClient.GetConfigRequest(key).Send(ctx)
 		require.NoError(t, err)
@@ -209,7 +208,7 @@ func testAccCheckExampleConfigurationDataSet(resourceName, path, expectedValue s
 func TestAccConfigResource(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(),
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
 		PreCheck:                 test.AccPreCheck,
 		Steps: []resource.TestStep{
 			// create empty config
@@ -294,13 +293,17 @@ func TestAccConfigResource(t *testing.T) {
 					testAccCheckExampleConfigurationDataSet("keboola_component_configuration.test", "storage.input.tables[0]", "in.data1"),
 				),
 			},
-			// Change configuration id - expects error
+			// Change configuration id - replaces the configuration
 			{
 				Config: test.ProviderConfig() + exGenericResource("test", map[string]any{
 					"name":             "test config",
 					"configuration_id": "aaa",
 				}),
-				ExpectError: regexp.MustCompile("Cannot change configuration_id after configuration is created"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("keboola_component_configuration.test", "id", regexp.MustCompile(`\d+/ex-generic-v2/aaa`)),
+					resource.TestCheckResourceAttr("keboola_component_configuration.test", "configuration_id", "aaa"),
+					testAccCheckExampleConfigMatchesReality(t, "keboola_component_configuration.test"),
+				),
 			},
 			// create configuration with id
 			{
@@ -328,7 +331,7 @@ func TestAccConfigResource(t *testing.T) {
 func TestAccConfigRowsCRUD(t *testing.T) { //nolint: paralleltest
 	// t.Parallel()
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(),
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
 		PreCheck:                 test.AccPreCheck,
 		Steps: []resource.TestStep{
 			// Create configuration with initial rows
@@ -524,3 +527,180 @@ func TestAccConfigRowsCRUD(t *testing.T) { //nolint: paralleltest
 		},
 	})
 }
+
+func TestAccConfigResetState(t *testing.T) {
+	t.Parallel()
+
+	config := func(trigger string) string {
+		return test.ProviderConfig() + exGenericResource("test_reset", map[string]any{
+			"name":                  "test config reset state",
+			"reset_state_on_change": trigger,
+			"rows": []map[string]any{
+				{"name": "First Row", "configuration_row": `{"parameters":{"endpoint":"endpoint1"}}`},
+			},
+		})
+	}
+	lastID := regexp.MustCompile(`"lastId": 123`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Create configuration with a row, then set their state as a job would do
+			{
+				Config: config("query-v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkAllAttributesSet("test_reset"),
+					resource.TestCheckResourceAttr("keboola_component_configuration.test_reset", "state", "{}"),
+					testAccSetConfigState(t, "keboola_component_configuration.test_reset", `{"lastId":123}`),
+				),
+			},
+			// The refreshed state is not empty
+			{
+				Config: config("query-v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("keboola_component_configuration.test_reset", "state", lastID),
+					resource.TestMatchResourceAttr("keboola_component_configuration.test_reset", "rows.0.state", lastID),
+				),
+			},
+			// Change the trigger, the state of the configuration and rows is cleared, the row content is kept
+			{
+				Config: config("query-v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_component_configuration.test_reset", "reset_state_on_change", "query-v2"),
+					resource.TestCheckResourceAttr("keboola_component_configuration.test_reset", "state", "{}"),
+					resource.TestCheckResourceAttr("keboola_component_configuration.test_reset", "rows.0.state", "{}"),
+					testAccCheckConfigStateCleared(t, "keboola_component_configuration.test_reset"),
+				),
+			},
+		},
+	})
+}
+
+// testAccConfigKey returns the key of the configuration resource in the state.
+func testAccConfigKey(s *terraform.State, resourceName string) (keboola.ConfigKey, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return keboola.ConfigKey{}, test.NewResourceNotFoundError(resourceName)
+	}
+
+	return configuration.ParseConfigModelID(rs.Primary.Attributes["id"])
+}
+
+// testAccSetConfigState sets the state of the configuration and of its rows outside of Terraform.
+func testAccSetConfigState(t *testing.T, resourceName, state string) resource.TestCheckFunc {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		key, err := testAccConfigKey(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		content := orderedmap.New()
+		if err := content.UnmarshalJSON([]byte(state)); err != nil {
+			return err
+		}
+
+		ctx := t.Context()
+		client := test.APIClient(t)
+		config := &keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, State: content}}
+		if _, err := client.UpdateConfigRequest(config, []string{"state"}).Send(ctx); err != nil {
+			return err
+		}
+
+		rows, err := client.ListConfigRowRequest(keboola.ConfigRowKey{
+			BranchID: key.BranchID, ComponentID: key.ComponentID, ConfigID: key.ID,
+		}).Send(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range *rows {
+			update := &keboola.ConfigRow{ConfigRowKey: row.ConfigRowKey, State: content}
+			if _, err := client.UpdateConfigRowRequest(update, []string{"state"}).Send(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckConfigStateCleared checks the remote state of the configuration and of its rows is empty,
+// and the content of the rows is kept.
+func testAccCheckConfigStateCleared(t *testing.T, resourceName string) resource.TestCheckFunc {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		key, err := testAccConfigKey(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		ctx := t.Context()
+		client := test.APIClient(t)
+		config, err := client.GetConfigRequest(key).Send(ctx)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, 0, config.State.Len())
+
+		rows, err := client.ListConfigRowRequest(keboola.ConfigRowKey{
+			BranchID: key.BranchID, ComponentID: key.ComponentID, ConfigID: key.ID,
+		}).Send(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range *rows {
+			assert.Equal(t, 0, row.State.Len(), row.ID)
+			assert.Equal(t, "endpoint1", row.Content.GetNestedOrNil("parameters.endpoint"), row.ID)
+		}
+
+		return nil
+	}
+}
+
+func TestAccConfigPurgeAndRestore(t *testing.T) {
+	t.Parallel()
+
+	purged := exGenericResource("purged", map[string]any{
+		"name":             "test config purge",
+		"configuration_id": "tfpurgeondestroy123",
+		"purge_on_destroy": true,
+	})
+	restored := exGenericResource("restored", map[string]any{
+		"name":               "test config restore",
+		"configuration_id":   "tfrestoreifdeleted123",
+		"restore_if_deleted": true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Create both configurations
+			{
+				Config: test.ProviderConfig() + purged + restored,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkAllAttributesSet("purged"),
+					checkAllAttributesSet("restored"),
+				),
+			},
+			// Destroy both, the first one is purged, the second one stays in trash
+			{
+				Config: test.ProviderConfig(),
+			},
+			// Create both again with the same IDs
+			{
+				Config: test.ProviderConfig() + purged + restored,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_component_configuration.purged", "configuration_id", "tfpurgeondestroy123"),
+					resource.TestCheckResourceAttr("keboola_component_configuration.restored", "configuration_id", "tfrestoreifdeleted123"),
+					resource.TestCheckResourceAttr("keboola_component_configuration.restored", "is_deleted", "false"),
+				),
+			},
+		},
+	})
+}
@@ -16,7 +16,7 @@ import (
 func TestAccEncryptionResource(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(),
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
 		PreCheck:                 test.AccPreCheck,
 		Steps: []resource.TestStep{
 			// Create and Read testing
@@ -0,0 +1,177 @@
+package job_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/abstraction"
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/job"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func newDoubleResource(t *testing.T) *double.Resource {
+	t.Helper()
+
+	client := double.New()
+	client.AddConfig(&keboola.ConfigWithRows{
+		Config: &keboola.Config{
+			ConfigKey: keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ID: "aaa"},
+			Content:   orderedmap.New(),
+		},
+		Rows: []*keboola.ConfigRow{{
+			ConfigRowKey: keboola.ConfigRowKey{
+				BranchID: double.DefaultBranchID, ComponentID: "ex-generic-v2", ConfigID: "aaa", ID: "row1",
+			},
+			Content: orderedmap.New(),
+		}},
+	})
+
+	return double.NewResource(t, client, job.NewResource())
+}
+
+// jobPlan returns a plan of the configuration aaa with the attributes, the branch is unknown, the others are null.
+func jobPlan(t *testing.T, r *double.Resource, attributes map[string]attr.Value) tfsdk.Plan {
+	t.Helper()
+
+	all := map[string]attr.Value{
+		"component_id":     types.StringValue("ex-generic-v2"),
+		"configuration_id": types.StringValue("aaa"),
+		"branch_id":        types.Int64Unknown(),
+	}
+	for name, value := range attributes {
+		all[name] = value
+	}
+
+	return r.Plan(t, all)
+}
+
+func TestJobResourceWithDouble(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+
+	// Create runs the job in the default branch
+	state, diags := r.Create(t, jobPlan(t, r, map[string]attr.Value{
+		"row_ids":         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("row1")}),
+		"variable_values": types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dev")}),
+	}))
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, 1, r.Client.Calls("CreateJob"))
+	assert.Equal(t, int64(double.DefaultBranchID), double.Int64Attribute(t, state, "branch_id"))
+	assert.Equal(t, abstraction.QueueJobStatusSuccess, double.StringAttribute(t, state, "status"))
+	_, err := time.Parse(time.RFC3339, double.StringAttribute(t, state, "start_time"))
+	require.NoError(t, err)
+	_, err = time.Parse(time.RFC3339, double.StringAttribute(t, state, "end_time"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), double.Int64Attribute(t, state, "duration_seconds"))
+
+	id := double.StringAttribute(t, state, "id")
+	remote, err := r.Client.GetJob(t.Context(), keboola.JobKey{ID: keboola.JobID(id)})
+	require.NoError(t, err)
+	assert.Equal(t, keboola.ConfigID("aaa"), remote.ConfigID)
+
+	// Read refreshes the job, the inputs are kept
+	state, diags = r.Read(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, id, double.StringAttribute(t, state, "id"))
+
+	var variables map[string]string
+	diags = state.GetAttribute(t.Context(), path.Root("variable_values"), &variables)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]string{"env": "dev"}, variables)
+
+	// Delete does not call the API
+	diags = r.Delete(t, state)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, 1, r.Client.Calls("CreateJob"))
+}
+
+func TestJobResourceWithDoubleFailedJob(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	r.Client.FinishJobsWith(abstraction.QueueJobStatusError, "Invalid credentials.")
+
+	// The failed job is stored, so Terraform taints the resource
+	state, diags := r.Create(t, jobPlan(t, r, nil))
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Job failed", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "finished with status error: Invalid credentials.")
+	assert.NotEmpty(t, double.StringAttribute(t, state, "id"))
+	assert.Equal(t, "Invalid credentials.", double.StringAttribute(t, state, "result_message"))
+}
+
+func TestJobResourceWithDoubleCreateTimeout(t *testing.T) {
+	t.Parallel()
+
+	r := newDoubleResource(t)
+	r.Client.FinishJobsWith(abstraction.QueueJobStatusProcessing, "")
+
+	// The running job is stored, so the next apply replaces the tainted resource instead of running another job
+	timeouts := types.ObjectValueMust(
+		map[string]attr.Type{
+			"create": types.StringType, "read": types.StringType, "update": types.StringType, "delete": types.StringType,
+		},
+		map[string]attr.Value{
+			"create": types.StringValue("10ms"), "read": types.StringNull(),
+			"update": types.StringNull(), "delete": types.StringNull(),
+		},
+	)
+	state, diags := r.Create(t, jobPlan(t, r, map[string]attr.Value{"timeouts": timeouts}))
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Job did not finish", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "context deadline exceeded")
+	assert.NotEmpty(t, double.StringAttribute(t, state, "id"))
+	assert.Equal(t, abstraction.QueueJobStatusProcessing, double.StringAttribute(t, state, "status"))
+	assert.Equal(t, 1, r.Client.Calls("CreateJob"))
+}
+
+func TestJobResourceWithDoubleErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing row", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		_, diags := r.Create(t, jobPlan(t, r, map[string]attr.Value{
+			"row_ids": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("missing")}),
+		}))
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "could not create job: row missing not found (404)")
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		r.Client.FailNext("CreateJob", double.ServerError(http.StatusServiceUnavailable))
+
+		_, diags := r.Create(t, jobPlan(t, r, nil))
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "could not create job: Service Unavailable (503)")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := newDoubleResource(t)
+		state, diags := r.Create(t, jobPlan(t, r, nil))
+		require.False(t, diags.HasError(), diags)
+
+		// The job has been removed by the retention, so it is removed from the state
+		r.Client.FailNext("GetJob", double.NotFound("job not found"))
+		state, diags = r.Read(t, state)
+		require.False(t, diags.HasError(), diags)
+		assert.True(t, state.Raw.IsNull())
+	})
+}
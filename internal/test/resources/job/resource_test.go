@@ -0,0 +1,83 @@
+package job_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+)
+
+func TestAccJobResource(t *testing.T) {
+	t.Parallel()
+
+	configuration := `
+resource "keboola_component_configuration" "test" {
+  name         = "Job test"
+  component_id = "ex-generic-v2"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Run a job of the configuration
+			{
+				Config: test.ProviderConfig() + configuration + `
+resource "keboola_job" "test" {
+  component_id     = keboola_component_configuration.test.component_id
+  configuration_id = keboola_component_configuration.test.configuration_id
+  triggers = {
+    version = "1"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keboola_job.test", "id"),
+					resource.TestCheckResourceAttrSet("keboola_job.test", "branch_id"),
+					resource.TestCheckResourceAttrSet("keboola_job.test", "end_time"),
+					resource.TestCheckResourceAttrSet("keboola_job.test", "duration_seconds"),
+					resource.TestCheckResourceAttr("keboola_job.test", "status", "success"),
+				),
+			},
+			// A change of the triggers runs a new job
+			{
+				Config: test.ProviderConfig() + configuration + `
+resource "keboola_job" "test" {
+  component_id     = keboola_component_configuration.test.component_id
+  configuration_id = keboola_component_configuration.test.configuration_id
+  triggers = {
+    version = "2"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_job.test", "triggers.version", "2"),
+					resource.TestCheckResourceAttr("keboola_job.test", "status", "success"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobResourceWithUnknownConfiguration(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: test.ProviderConfig() + `
+resource "keboola_job" "test" {
+  component_id     = "ex-generic-v2"
+  configuration_id = "unknown"
+}
+`,
+				ExpectError: regexp.MustCompile("could not create job"),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keboola/go-utils/pkg/orderedmap"
+	"github.com/keboola/keboola-sdk-go/v2/pkg/keboola"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/scheduler"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func TestSchedulerResourceWithDoubleIdentity(t *testing.T) {
+	t.Parallel()
+
+	r := double.NewResource(t, double.New(), scheduler.NewResource())
+	key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "keboola.scheduler", ID: "sched"}
+	r.Client.AddConfig(&keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, Content: orderedmap.New()}})
+
+	// The create sets the identity
+	state, diags := r.Create(t, r.Plan(t, map[string]attr.Value{"configuration_id": types.StringValue("sched")}))
+	require.False(t, diags.HasError(), diags)
+	id := double.StringAttribute(t, state, "id")
+	assert.Equal(t, id, double.IdentityAttribute[types.String](t, r, "id").ValueString())
+
+	// The import by the ID sets the identity
+	imported, diags := r.ImportState(t, id)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, id, double.StringAttribute(t, imported, "id"))
+	assert.Equal(t, id, double.IdentityAttribute[types.String](t, r, "id").ValueString())
+
+	// The import by the identity sets the ID
+	imported, diags = r.ImportStateByIdentity(t, map[string]attr.Value{"id": types.StringValue(id)})
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, id, double.StringAttribute(t, imported, "id"))
+	assert.Equal(t, id, double.IdentityAttribute[types.String](t, r, "id").ValueString())
+
+	// The read keeps the identity
+	r.Identity = nil
+	state, diags = r.Read(t, imported)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "sched", double.StringAttribute(t, state, "configuration_id"))
+	assert.Equal(t, id, double.IdentityAttribute[types.String](t, r, "id").ValueString())
+}
+
+func TestSchedulerListResourceWithDouble(t *testing.T) {
+	t.Parallel()
+
+	client := double.New()
+	r := double.NewResource(t, client, scheduler.NewResource())
+	l := double.NewListResource(t, client, scheduler.NewListResource(), scheduler.NewResource())
+	var ids []string
+	for _, configID := range []keboola.ConfigID{"sched1", "sched2"} {
+		key := keboola.ConfigKey{BranchID: double.DefaultBranchID, ComponentID: "keboola.scheduler", ID: configID}
+		client.AddConfig(&keboola.ConfigWithRows{Config: &keboola.Config{ConfigKey: key, Content: orderedmap.New()}})
+		plan := r.Plan(t, map[string]attr.Value{"configuration_id": types.StringValue(configID.String())})
+		state, diags := r.Create(t, plan)
+		require.False(t, diags.HasError(), diags)
+		ids = append(ids, double.StringAttribute(t, state, "id"))
+	}
+
+	// The schedules are sorted by the ID
+	results := l.List(t, nil, false)
+	require.Len(t, results, 2)
+	for i, result := range results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		assert.Equal(t, ids[i], result.DisplayName)
+		assert.Equal(t, ids[i], double.ListIdentityAttribute[types.String](t, result, "id").ValueString())
+	}
+
+	// The resource is the state of the import followed by a read
+	results = l.List(t, map[string]attr.Value{"configuration_id": types.StringValue("sched2")}, true)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Diagnostics.HasError(), results[0].Diagnostics)
+	listed := double.State(t, results[0])
+	assert.Equal(t, ids[1], double.StringAttribute(t, listed, "id"))
+	assert.Equal(t, "sched2", double.StringAttribute(t, listed, "configuration_id"))
+
+	imported, diags := r.ImportStateByIdentity(t, map[string]attr.Value{
+		"id": double.ListIdentityAttribute[types.String](t, results[0], "id"),
+	})
+	require.False(t, diags.HasError(), diags)
+	imported, diags = r.Read(t, imported)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, listed.Raw, imported.Raw)
+}
@@ -51,7 +51,7 @@ func TestAccSchedulerResource(t *testing.T) {
 	t.Parallel()
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(),
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
 		PreCheck:                 test.AccPreCheck,
 		ExternalProviders: map[string]resource.ExternalProvider{
 			"random": {
@@ -124,6 +124,13 @@ func TestAccSchedulerResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("keboola_component_configuration.test_config_scheduler", "configuration_id"),
 				),
 			},
+			// Import the schedule by its ID
+			{
+				ResourceName:            "keboola_scheduler.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
 			// Attempt to update the schedule by changing the underlying config's cronTab - should force replacement
 			{
 				Config: test.ProviderConfig() + testSchedulerResource("test", map[string]any{
@@ -140,7 +147,7 @@ func TestSchedulerCreateWithInvalidConfigID(t *testing.T) {
 	t.Parallel()
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(),
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
 		PreCheck:                 test.AccPreCheck,
 		ExternalProviders: map[string]resource.ExternalProvider{
 			"random": {
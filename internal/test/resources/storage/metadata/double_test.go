@@ -0,0 +1,58 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/resources/storage/metadata"
+	"github.com/keboola/terraform-provider-keboola/internal/test/double"
+)
+
+func TestStorageMetadataResourceWithDouble(t *testing.T) {
+	t.Parallel()
+
+	targets := map[string]map[string]attr.Value{
+		"bucket": {"bucket_id": types.StringValue("in.c-main")},
+		"table":  {"table_id": types.StringValue("in.c-main.users")},
+		"column": {"table_id": types.StringValue("in.c-main.users"), "column_name": types.StringValue("name")},
+	}
+
+	for name, target := range targets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := double.NewResource(t, double.New(), metadata.NewResource())
+			attributes := map[string]attr.Value{
+				"branch_id":         types.Int64Unknown(),
+				"metadata_provider": types.StringValue(metadata.DefaultProvider),
+				"key":               types.StringValue("KBC.description"),
+				"value":             types.StringValue("users"),
+			}
+			for attribute, value := range target {
+				attributes[attribute] = value
+			}
+
+			// Create
+			state, diags := r.Create(t, r.Plan(t, attributes))
+			require.False(t, diags.HasError(), diags)
+			assert.NotEmpty(t, double.StringAttribute(t, state, "id"))
+			assert.Equal(t, int64(double.DefaultBranchID), double.Int64Attribute(t, state, "branch_id"))
+
+			// Read
+			state, diags = r.Read(t, state)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, "users", double.StringAttribute(t, state, "value"))
+
+			// The entry deleted outside of Terraform is removed from the state, so it is created again
+			diags = r.Delete(t, state)
+			require.False(t, diags.HasError(), diags)
+			state, diags = r.Read(t, state)
+			require.False(t, diags.HasError(), diags)
+			assert.True(t, state.Raw.IsNull())
+		})
+	}
+}
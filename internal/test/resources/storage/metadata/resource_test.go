@@ -0,0 +1,124 @@
+package metadata_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+)
+
+func testResource(resourceID string, resourceDefinition map[string]any) string {
+	result := `resource "keboola_storage_metadata" "` + resourceID + `" {`
+	for attribute, value := range resourceDefinition {
+		var pair string
+		switch v := value.(type) {
+		case string:
+			pair = fmt.Sprintf("%s = %v ", attribute, strconv.Quote(v))
+		default:
+			pair = fmt.Sprintf("%s = %v ", attribute, v)
+		}
+		result = result + "\n" + pair
+	}
+	result = result + "\n" + "}\n"
+
+	return result
+}
+
+func TestAccStorageMetadataResource(t *testing.T) {
+	t.Parallel()
+
+	// The provider cannot manage buckets yet, so an existing bucket of the test project is used
+	bucketID := test.BucketID()
+	if bucketID == "" {
+		t.Skip("TEST_KBC_BUCKET_ID must be set to run the storage metadata acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Create bucket description
+			{
+				Config: test.ProviderConfig() + testResource("description", map[string]any{
+					"bucket_id": bucketID,
+					"key":       "KBC.description",
+					"value":     "Test Bucket Description",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keboola_storage_metadata.description", "id"),
+					resource.TestCheckResourceAttrSet("keboola_storage_metadata.description", "branch_id"),
+					resource.TestCheckResourceAttr("keboola_storage_metadata.description", "metadata_provider", "user"),
+					resource.TestCheckResourceAttr("keboola_storage_metadata.description", "value", "Test Bucket Description"),
+				),
+			},
+			// Update the value
+			{
+				Config: test.ProviderConfig() + testResource("description", map[string]any{
+					"bucket_id": bucketID,
+					"key":       "KBC.description",
+					"value":     "Test Bucket New Description",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_storage_metadata.description", "value", "Test Bucket New Description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccStorageMetadataResourceTableAndColumn(t *testing.T) {
+	t.Parallel()
+
+	// The provider cannot manage tables yet, so an existing table of the test project is used
+	tableID, column := test.TableID(), test.TableColumn()
+	if tableID == "" || column == "" {
+		t.Skip("TEST_KBC_TABLE_ID and TEST_KBC_TABLE_COLUMN must be set to run the storage metadata acceptance test")
+	}
+
+	config := func(tableValue, columnValue string) string {
+		return test.ProviderConfig() +
+			testResource("table", map[string]any{
+				"table_id": tableID,
+				"key":      "KBC.description",
+				"value":    tableValue,
+			}) +
+			testResource("column", map[string]any{
+				"table_id":          tableID,
+				"column_name":       column,
+				"metadata_provider": "terraform",
+				"key":               "KBC.datatype.basetype",
+				"value":             columnValue,
+			})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Create table and column metadata
+			{
+				Config: config("Test Table Description", "STRING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keboola_storage_metadata.table", "id"),
+					resource.TestCheckResourceAttr("keboola_storage_metadata.table", "value", "Test Table Description"),
+					resource.TestCheckResourceAttrSet("keboola_storage_metadata.column", "id"),
+					resource.TestCheckResourceAttr("keboola_storage_metadata.column", "metadata_provider", "terraform"),
+					resource.TestCheckResourceAttr("keboola_storage_metadata.column", "value", "STRING"),
+				),
+			},
+			// Update the values
+			{
+				Config: config("Test Table New Description", "INTEGER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_storage_metadata.table", "value", "Test Table New Description"),
+					resource.TestCheckResourceAttr("keboola_storage_metadata.column", "value", "INTEGER"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/keboola/terraform-provider-keboola/internal/test"
+)
+
+// testTokenResource returns the token configuration, the extra lines are appended to the resource block.
+func testTokenResource(rotation, extra string) string {
+	return `
+resource "keboola_storage_token" "test" {
+  description               = "terraform acceptance test token"
+  can_manage_buckets        = false
+  can_read_all_file_uploads = true
+  component_access          = ["ex-generic-v2"]
+  expires_in                = 3600
+  rotation                  = "` + rotation + `"
+` + extra + `}
+`
+}
+
+// keepsToken checks that the token value has not changed.
+func keepsToken(expected *string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith("keboola_storage_token.test", "token", func(value string) error {
+		if value == "" || value != *expected {
+			return test.NewAttributeMismatchError("token", "the stored token", value)
+		}
+
+		return nil
+	})
+}
+
+func TestAccStorageTokenResource(t *testing.T) {
+	t.Parallel()
+
+	var firstToken string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories(t),
+		PreCheck:                 test.AccPreCheck,
+		Steps: []resource.TestStep{
+			// Create a token
+			{
+				Config: test.ProviderConfig() + testTokenResource("1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("keboola_storage_token.test", "id"),
+					resource.TestCheckResourceAttrSet("keboola_storage_token.test", "token"),
+					resource.TestCheckResourceAttrSet("keboola_storage_token.test", "expires"),
+					resource.TestCheckResourceAttr("keboola_storage_token.test", "can_read_all_file_uploads", "true"),
+					resource.TestCheckResourceAttr("keboola_storage_token.test", "component_access.#", "1"),
+					resource.TestCheckResourceAttrWith("keboola_storage_token.test", "token", func(value string) error {
+						firstToken = value
+
+						return nil
+					}),
+				),
+			},
+			// Change the timeouts in place, the token is not rotated and its value is kept
+			{
+				Config: test.ProviderConfig() + testTokenResource("1", "  timeouts {\n    update = \"5m\"\n  }\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_storage_token.test", "timeouts.update", "5m"),
+					keepsToken(&firstToken),
+				),
+			},
+			// Toggle the deletion protection in place, the token value is kept
+			{
+				Config: test.ProviderConfig() + testTokenResource("1", "  deletion_protection = true\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_storage_token.test", "deletion_protection", "true"),
+					keepsToken(&firstToken),
+				),
+			},
+			{
+				Config: test.ProviderConfig() + testTokenResource("1", "  deletion_protection = false\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keboola_storage_token.test", "deletion_protection", "false"),
+					keepsToken(&firstToken),
+				),
+			},
+			// Rotate the token
+			{
+				Config: test.ProviderConfig() + testTokenResource("2", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("keboola_storage_token.test", "token", func(value string) error {
+						if value == firstToken {
+							return test.NewAttributeMismatchError("token", "rotated token", value)
+						}
+
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
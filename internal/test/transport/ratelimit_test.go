@@ -0,0 +1,114 @@
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/transport"
+)
+
+func TestRateLimitTransportCapsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	limiter := transport.NewRateLimiter(transport.RateLimitConfig{MaxConcurrent: 2})
+	client := &http.Client{Transport: transport.NewRateLimitTransport(http.DefaultTransport, limiter)}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+			assert.NoError(t, err)
+			resp, err := client.Do(req)
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestRateLimiterLimitsRate(t *testing.T) {
+	t.Parallel()
+
+	limiter := transport.NewRateLimiter(transport.RateLimitConfig{RequestsPerSecond: 10})
+
+	// The burst equals the rate, the following requests wait for the limiter
+	start := time.Now()
+	for range 12 {
+		release, err := limiter.Acquire(t.Context())
+		require.NoError(t, err)
+		release()
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
+
+func TestRateLimiterLimitsRateBelowOnePerSecond(t *testing.T) {
+	t.Parallel()
+
+	limiter := transport.NewRateLimiter(transport.RateLimitConfig{RequestsPerSecond: 0.5})
+
+	// The first request is allowed immediately, the next one waits for two seconds
+	release, err := limiter.Acquire(t.Context())
+	require.NoError(t, err)
+	release()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	_, err = limiter.Acquire(ctx)
+	require.Error(t, err)
+}
+
+func TestRateLimiterRespectsContext(t *testing.T) {
+	t.Parallel()
+
+	limiter := transport.NewRateLimiter(transport.RateLimitConfig{MaxConcurrent: 1})
+	release, err := limiter.Acquire(t.Context())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limiter.Acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	limiter := transport.NewRateLimiter(transport.RateLimitConfig{})
+	for range 100 {
+		release, err := limiter.Acquire(t.Context())
+		require.NoError(t, err)
+		release()
+	}
+}
@@ -0,0 +1,150 @@
+package transport_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/keboola/terraform-provider-keboola/internal/provider/transport"
+)
+
+func testRetryConfig(maxAttempts int) transport.RetryConfig {
+	return transport.RetryConfig{
+		MaxAttempts: maxAttempts,
+		InitialWait: time.Millisecond,
+		MaxWait:     10 * time.Millisecond,
+	}
+}
+
+// newFlakyServer returns a server responding with the given status codes, then with 200.
+func newFlakyServer(t *testing.T, statusCodes []int, calls *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		body, _ := io.ReadAll(r.Body)
+		if call <= len(statusCodes) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(statusCodes[call-1])
+
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRetryTransportRetriesIdempotentRequests(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := newFlakyServer(t, []int{http.StatusBadGateway, http.StatusTooManyRequests}, &calls)
+	client := &http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, testRetryConfig(5))}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "payload", string(body))
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryTransportDoesNotDuplicateCreates(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := newFlakyServer(t, []int{http.StatusBadGateway}, &calls)
+	client := &http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, testRetryConfig(5))}
+
+	// 502 may be returned after the create has been processed, the POST must not be repeated
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransportDoesNotRepeatDeletes(t *testing.T) {
+	t.Parallel()
+
+	// 502 may be returned after the configuration has been moved to trash, a repeated DELETE would purge it
+	for _, statusCode := range []int{http.StatusBadGateway, http.StatusGatewayTimeout} {
+		var calls atomic.Int32
+		server := newFlakyServer(t, []int{statusCode}, &calls)
+		client := &http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, testRetryConfig(5))}
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodDelete, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		assert.Equal(t, statusCode, resp.StatusCode)
+		assert.Equal(t, int32(1), calls.Load(), statusCode)
+	}
+
+	// 503 is returned before the request is processed
+	var calls atomic.Int32
+	server := newFlakyServer(t, []int{http.StatusServiceUnavailable}, &calls)
+	client := &http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, testRetryConfig(5))}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodDelete, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryTransportRetriesRateLimitedCreates(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := newFlakyServer(t, []int{http.StatusTooManyRequests}, &calls)
+	client := &http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, testRetryConfig(5))}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryTransportMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := newFlakyServer(t, []int{503, 503, 503, 503}, &calls)
+	client := &http.Client{Transport: transport.NewRetryTransport(http.DefaultTransport, testRetryConfig(3))}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
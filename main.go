@@ -4,9 +4,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
+	"github.com/keboola/terraform-provider-keboola/internal/generator"
 	"github.com/keboola/terraform-provider-keboola/internal/provider"
 )
 
@@ -33,9 +35,33 @@ var version = "dev"
 
 func main() {
 	var debug bool
+	var exportDir string
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(
+		&exportDir,
+		"export",
+		"",
+		"instead of running the provider, write the Terraform configuration of an existing project to the directory, "+
+			"the project is read using the "+provider.KbcHost+" and "+provider.KbcToken+" environment variables",
+	)
 	flag.Parse()
+
+	if exportDir != "" {
+		host := os.Getenv(provider.KbcHost)   //nolint: forbidigo
+		token := os.Getenv(provider.KbcToken) //nolint: forbidigo
+		if host == "" || token == "" {
+			log.Fatalf("the %s and %s environment variables must be set", provider.KbcHost, provider.KbcToken)
+		}
+
+		if err := generator.Export(context.Background(), host, token, exportDir); err != nil {
+			log.Fatal(err.Error())
+		}
+		log.Printf("the project has been exported to %s, run \"terraform plan\" there to review the imports", exportDir)
+
+		return
+	}
+
 	err := providerserver.Serve(context.Background(), provider.New(version), providerserver.ServeOpts{
 		Address:         "registry.terraform.io/keboola/keboola",
 		Debug:           debug,